@@ -0,0 +1,79 @@
+package slices
+
+// At returns the element at index i of the slice s. A negative index counts
+// from the end of the slice, i.e. -1 refers to the last element.
+//
+// If i is out of range, an error is returned.
+func At[E any](s []E, i int) (zeroValue E, _ error) {
+	if i < 0 {
+		i += len(s)
+	}
+	if !validIndex(s, i) {
+		return zeroValue, errIndexOutOfRange
+	}
+	return s[i], nil
+}
+
+// Get returns the element at index i of the slice s and reports whether the
+// index was in range. A negative index counts from the end of the slice,
+// i.e. -1 refers to the last element.
+func Get[E any](s []E, i int) (E, bool) {
+	e, err := At(s, i)
+	return e, err == nil
+}
+
+// First returns the first element of the slice s.
+//
+// If the slice is empty, an error is returned.
+func First[E any](s []E) (zeroValue E, _ error) {
+	if len(s) == 0 {
+		return zeroValue, errEmptySlice
+	}
+	return s[0], nil
+}
+
+// Last returns the last element of the slice s.
+//
+// If the slice is empty, an error is returned.
+func Last[E any](s []E) (zeroValue E, _ error) {
+	if len(s) == 0 {
+		return zeroValue, errEmptySlice
+	}
+	return s[len(s)-1], nil
+}
+
+// FirstOr returns the first element of the slice s or the value v
+// if the slice is empty.
+func FirstOr[E any](s []E, v E) E {
+	if len(s) == 0 {
+		return v
+	}
+	return s[0]
+}
+
+// LastOr returns the last element of the slice s or the value v
+// if the slice is empty.
+func LastOr[E any](s []E, v E) E {
+	if len(s) == 0 {
+		return v
+	}
+	return s[len(s)-1]
+}
+
+// Slice returns a newly allocated slice containing the elements s[from:to].
+// Negative bounds count from the end of the slice, i.e. -1 refers to the
+// last element. Bounds outside of the slice are clamped to its length and
+// if from is not before to, the result is empty.
+func Slice[E any](s []E, from, to int) []E {
+	if from < 0 {
+		from += len(s)
+	}
+	if to < 0 {
+		to += len(s)
+	}
+	from, to = clamp(from, len(s)), clamp(to, len(s))
+	if from >= to {
+		return []E{}
+	}
+	return clone(s[from:to])
+}
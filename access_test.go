@@ -0,0 +1,103 @@
+package slices
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestAt(t *testing.T) {
+	tests := []struct {
+		s    []int
+		i, e int
+		err  error
+	}{
+		{s: nil, i: 0, e: 0, err: errIndexOutOfRange},
+		{s: nil, i: -1, e: 0, err: errIndexOutOfRange},
+		{s: []int{1, 2, 3}, i: 0, e: 1, err: nil},
+		{s: []int{1, 2, 3}, i: 2, e: 3, err: nil},
+		{s: []int{1, 2, 3}, i: 3, e: 0, err: errIndexOutOfRange},
+		{s: []int{1, 2, 3}, i: -1, e: 3, err: nil},
+		{s: []int{1, 2, 3}, i: -3, e: 1, err: nil},
+		{s: []int{1, 2, 3}, i: -4, e: 0, err: errIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		e, err := At(test.s, test.i)
+		assertEqual(t, test.e, e)
+		assertEqual(t, test.err, err)
+	}
+}
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		s    []int
+		i, e int
+		ok   bool
+	}{
+		{s: nil, i: 0, e: 0, ok: false},
+		{s: []int{1, 2, 3}, i: 1, e: 2, ok: true},
+		{s: []int{1, 2, 3}, i: -2, e: 2, ok: true},
+		{s: []int{1, 2, 3}, i: 5, e: 0, ok: false},
+	}
+
+	for _, test := range tests {
+		e, ok := Get(test.s, test.i)
+		assertEqual(t, test.e, e)
+		assertEqual(t, test.ok, ok)
+	}
+}
+
+func TestFirst(t *testing.T) {
+	e, err := First([]int{1, 2, 3})
+	assertEqual(t, 1, e)
+	assertNil(t, err)
+
+	e, err = First([]int{})
+	assertEqual(t, 0, e)
+	assertEqual(t, errEmptySlice, err)
+}
+
+func TestLast(t *testing.T) {
+	e, err := Last([]int{1, 2, 3})
+	assertEqual(t, 3, e)
+	assertNil(t, err)
+
+	e, err = Last[int](nil)
+	assertEqual(t, 0, e)
+	assertEqual(t, errEmptySlice, err)
+}
+
+func TestFirstOr(t *testing.T) {
+	assertEqual(t, 1, FirstOr([]int{1, 2, 3}, -1))
+	assertEqual(t, -1, FirstOr(nil, -1))
+}
+
+func TestLastOr(t *testing.T) {
+	assertEqual(t, 3, LastOr([]int{1, 2, 3}, -1))
+	assertEqual(t, -1, LastOr(nil, -1))
+}
+
+func TestSlice(t *testing.T) {
+	tests := []struct {
+		s        []int
+		from, to int
+		e        []int
+	}{
+		{s: nil, from: 0, to: 2, e: []int{}},
+		{s: []int{1, 2, 3, 4}, from: 1, to: 3, e: []int{2, 3}},
+		{s: []int{1, 2, 3, 4}, from: -2, to: 4, e: []int{3, 4}},
+		{s: []int{1, 2, 3, 4}, from: 0, to: -1, e: []int{1, 2, 3}},
+		{s: []int{1, 2, 3, 4}, from: -10, to: 10, e: []int{1, 2, 3, 4}},
+		{s: []int{1, 2, 3, 4}, from: 3, to: 1, e: []int{}},
+		{s: []int{1, 2, 3, 4}, from: -1, to: -3, e: []int{}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, Slice(test.s, test.from, test.to))
+	}
+
+	s := []int{1, 2, 3}
+	if r := Slice(s, 0, 2); unsafe.Pointer(&s[0]) == unsafe.Pointer(&r[0]) {
+		t.Errorf("Test %s: Expected s1 and s2 to not be the same slice", t.Name())
+	}
+}
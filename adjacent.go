@@ -0,0 +1,171 @@
+package slices
+
+// ChunkWhile splits the slice into runs of consecutive elements, starting a
+// new run between two adjacent elements whenever the function fn returns
+// false for them. Each run is a newly allocated slice.
+func ChunkWhile[E any](s []E, fn func(prev, next E) bool) [][]E {
+	r := make([][]E, 0)
+	j := 0
+	for i := 1; i <= len(s); i++ {
+		if i == len(s) || !fn(s[i-1], s[i]) {
+			r = append(r, clone(s[j:i]))
+			j = i
+		}
+	}
+	return r
+}
+
+// GroupAdjacentBy splits the slice into runs of consecutive elements having
+// equal keys returned by the function fn. It returns the runs in order as
+// pairs of their key and a newly allocated slice of their elements.
+//
+// Unlike GroupBy, elements with the same key are only grouped together if
+// they are adjacent, thus the same key may occur in multiple runs.
+func GroupAdjacentBy[E any, K comparable](s []E, fn func(e E) K) []Pair[K, []E] {
+	r := make([]Pair[K, []E], 0)
+	if len(s) == 0 {
+		return r
+	}
+	j, k := 0, fn(s[0])
+	for i := 1; i <= len(s); i++ {
+		var next K
+		if i < len(s) {
+			if next = fn(s[i]); next == k {
+				continue
+			}
+		}
+		r = append(r, Pair[K, []E]{k, clone(s[j:i])})
+		j, k = i, next
+	}
+	return r
+}
+
+// SplitAt splits the slice at each element for which the function fn returns
+// true. The matching elements are not included in the result. Each part is a
+// newly allocated slice.
+//
+// Like strings.Split, adjacent matches produce empty parts and the result
+// always contains one more part than there are matching elements.
+func SplitAt[E any](s []E, fn func(e E) bool) [][]E {
+	r := make([][]E, 0, 1)
+	j := 0
+	for i, e := range s {
+		if fn(e) {
+			r = append(r, clone(s[j:i]))
+			j = i + 1
+		}
+	}
+	return append(r, clone(s[j:]))
+}
+
+// SplitOn splits the slice at each occurrence of the separator sep.
+// The separators are not included in the result. Each part is a newly
+// allocated slice.
+//
+// Like strings.Split, adjacent separators produce empty parts and the result
+// always contains one more part than there are separators.
+func SplitOn[E comparable](s []E, sep E) [][]E {
+	return SplitAt(s, func(e E) bool { return e == sep })
+}
+
+// Compact returns a slice where every run of adjacent equal elements of the
+// slice s is replaced by a single element. Unlike Unique, repeated elements
+// which are not adjacent are retained.
+func Compact[E comparable](s []E) []E {
+	return CompactFunc(s, func(a, b E) bool { return a == b })
+}
+
+// CompactInPlace returns a slice where every run of adjacent equal elements
+// of the slice s is replaced by a single element.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func CompactInPlace[E comparable](s []E) []E {
+	return compactFunc(s, s, func(a, b E) bool { return a == b })
+}
+
+// CompactFunc returns a slice where every run of adjacent elements of the
+// slice s, for which the function eq returns true, is replaced by the first
+// element of the run.
+func CompactFunc[E any](s []E, eq func(a, b E) bool) []E {
+	return compactFunc(s, make([]E, len(s)), eq)
+}
+
+// CompactBy returns a slice where every run of adjacent elements of the
+// slice s having equal keys returned by the given selector function fn is
+// replaced by the first element of the run.
+func CompactBy[E1 any, E2 comparable](s []E1, fn func(e E1) E2) []E1 {
+	return compactBy(s, make([]E1, len(s)), fn)
+}
+
+// CompactByInPlace returns a slice where every run of adjacent elements of
+// the slice s having equal keys returned by the given selector function fn
+// is replaced by the first element of the run.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func CompactByInPlace[E1 any, E2 comparable](s []E1, fn func(e E1) E2) []E1 {
+	return compactBy(s, s, fn)
+}
+
+// compactFunc writes the first element of each run of the slice s into r,
+// which may share the underlying array of slice s.
+func compactFunc[E any](s, r []E, eq func(a, b E) bool) []E {
+	n := 0
+	for i, e := range s {
+		if i == 0 || !eq(r[n-1], e) {
+			r[n] = e
+			n++
+		}
+	}
+	return r[:n:n]
+}
+
+// compactBy writes the first element of each run of the slice s into r,
+// which may share the underlying array of slice s. The key of each element
+// is computed only once.
+func compactBy[E1 any, E2 comparable](s, r []E1, fn func(e E1) E2) []E1 {
+	n := 0
+	var prev E2
+	for i, e := range s {
+		if key := fn(e); i == 0 || key != prev {
+			prev = key
+			r[n] = e
+			n++
+		}
+	}
+	return r[:n:n]
+}
+
+// RunLengthEncode returns the runs of adjacent equal elements of the slice s
+// as pairs of the element and the length of the run.
+func RunLengthEncode[E comparable](s []E) []Pair[E, int] {
+	r := make([]Pair[E, int], 0)
+	for _, e := range s {
+		if n := len(r); n > 0 && r[n-1].First == e {
+			r[n-1].Second++
+			continue
+		}
+		r = append(r, Pair[E, int]{e, 1})
+	}
+	return r
+}
+
+// RunLengthDecode returns a slice where each pair of an element and a count
+// in the slice s is expanded into a run of that many copies of the element.
+// Runs with a count of zero or less are omitted.
+func RunLengthDecode[E any](s []Pair[E, int]) []E {
+	n := SumOf(s, func(p Pair[E, int]) int {
+		if p.Second < 0 {
+			return 0
+		}
+		return p.Second
+	})
+	r := make([]E, 0, n)
+	for _, p := range s {
+		for i := 0; i < p.Second; i++ {
+			r = append(r, p.First)
+		}
+	}
+	return r
+}
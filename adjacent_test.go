@@ -0,0 +1,190 @@
+package slices
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestChunkWhile(t *testing.T) {
+	tests := []struct {
+		s []int
+		e [][]int
+	}{
+		{s: nil, e: [][]int{}},
+		{s: []int{1}, e: [][]int{{1}}},
+		{s: []int{1, 2, 3, 5, 6, 9}, e: [][]int{{1, 2, 3}, {5, 6}, {9}}},
+		{s: []int{1, 3, 5}, e: [][]int{{1}, {3}, {5}}},
+	}
+
+	for _, test := range tests {
+		chunks := ChunkWhile(test.s, func(prev, next int) bool { return next == prev+1 })
+		assertEqual(t, test.e, chunks)
+		if len(chunks) > 0 && unsafe.Pointer(&test.s[0]) == unsafe.Pointer(&chunks[0][0]) {
+			t.Errorf("Test %s: Expected s1 and s2 to not be the same slice", t.Name())
+		}
+	}
+}
+
+func TestGroupAdjacentBy(t *testing.T) {
+	type Line struct {
+		request, message string
+	}
+
+	tests := []struct {
+		s []Line
+		e []Pair[string, []Line]
+	}{
+		{s: nil, e: []Pair[string, []Line]{}},
+		{
+			s: []Line{{"a", "start"}, {"a", "end"}, {"b", "start"}, {"a", "retry"}},
+			e: []Pair[string, []Line]{
+				{"a", []Line{{"a", "start"}, {"a", "end"}}},
+				{"b", []Line{{"b", "start"}}},
+				{"a", []Line{{"a", "retry"}}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, GroupAdjacentBy(test.s, func(l Line) string { return l.request }))
+	}
+}
+
+func TestSplitAt(t *testing.T) {
+	tests := []struct {
+		s []int
+		e [][]int
+	}{
+		{s: nil, e: [][]int{{}}},
+		{s: []int{1, 2, 3}, e: [][]int{{1, 2, 3}}},
+		{s: []int{1, -1, 2, 3, -2, 4}, e: [][]int{{1}, {2, 3}, {4}}},
+		{s: []int{-1, 1, -1, -1}, e: [][]int{{}, {1}, {}, {}}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, SplitAt(test.s, func(i int) bool { return i < 0 }))
+	}
+}
+
+func TestSplitOn(t *testing.T) {
+	tests := []struct {
+		s []string
+		e [][]string
+	}{
+		{s: nil, e: [][]string{{}}},
+		{s: []string{"a", "", "b", "c", ""}, e: [][]string{{"a"}, {"b", "c"}, {}}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, SplitOn(test.s, ""))
+	}
+}
+
+func TestCompact(t *testing.T) {
+	tests := []struct {
+		s, e []int
+	}{
+		{s: nil, e: []int{}},
+		{s: []int{1}, e: []int{1}},
+		{s: []int{1, 1, 2, 2, 2, 1, 3, 3}, e: []int{1, 2, 1, 3}},
+	}
+
+	for _, test := range tests {
+		compact := Compact(test.s)
+		assertEqual(t, test.e, compact)
+		if len(compact) > 0 && unsafe.Pointer(&test.s[0]) == unsafe.Pointer(&compact[0]) {
+			t.Errorf("Test %s: Expected s1 and s2 to not be the same slice", t.Name())
+		}
+	}
+}
+
+func TestCompactInPlace(t *testing.T) {
+	tests := []struct {
+		s, e []int
+	}{
+		{s: []int{1, 1, 2, 2, 2, 1, 3, 3}, e: []int{1, 2, 1, 3}},
+	}
+
+	for _, test := range tests {
+		compact := CompactInPlace(test.s)
+		assertEqual(t, test.e, compact)
+		assertEqual(t, cap(test.e), cap(compact))
+		assertEqual(t, unsafe.Pointer(&test.s[0]), unsafe.Pointer(&compact[0]))
+	}
+}
+
+func TestCompactFunc(t *testing.T) {
+	tests := []struct {
+		s, e []float64
+	}{
+		{s: nil, e: []float64{}},
+		{s: []float64{1.0, 1.05, 1.08, 2.0, 1.0}, e: []float64{1.0, 2.0, 1.0}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, CompactFunc(test.s, func(a, b float64) bool { return b-a < 0.1 && a-b < 0.1 }))
+	}
+}
+
+func TestCompactBy(t *testing.T) {
+	type Reading struct {
+		sensor string
+		value  int
+	}
+
+	tests := []struct {
+		s, e []Reading
+	}{
+		{s: nil, e: []Reading{}},
+		{
+			s: []Reading{{"a", 1}, {"b", 1}, {"c", 2}, {"d", 1}},
+			e: []Reading{{"a", 1}, {"c", 2}, {"d", 1}},
+		},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, CompactBy(test.s, func(r Reading) int { return r.value }))
+	}
+}
+
+func TestCompactByInPlace(t *testing.T) {
+	s := []string{"a", "ab", "b", "abc", "bc"}
+	compact := CompactByInPlace(s, func(s string) int { return len(s) })
+	assertEqual(t, []string{"a", "ab", "b", "abc", "bc"}, compact)
+
+	s = []string{"a", "b", "ab", "bc", "c"}
+	compact = CompactByInPlace(s, func(s string) int { return len(s) })
+	assertEqual(t, []string{"a", "ab", "c"}, compact)
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&compact[0]))
+}
+
+func TestRunLengthEncode(t *testing.T) {
+	tests := []struct {
+		s []string
+		e []Pair[string, int]
+	}{
+		{s: nil, e: []Pair[string, int]{}},
+		{s: []string{"a", "a", "b", "a", "a", "a"}, e: []Pair[string, int]{{"a", 2}, {"b", 1}, {"a", 3}}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, RunLengthEncode(test.s))
+	}
+}
+
+func TestRunLengthDecode(t *testing.T) {
+	tests := []struct {
+		s []Pair[string, int]
+		e []string
+	}{
+		{s: nil, e: []string{}},
+		{s: []Pair[string, int]{{"a", 2}, {"b", 0}, {"c", -1}, {"a", 3}}, e: []string{"a", "a", "a", "a", "a"}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, RunLengthDecode(test.s))
+	}
+
+	s := []string{"x", "y", "y", "x"}
+	assertEqual(t, s, RunLengthDecode(RunLengthEncode(s)))
+}
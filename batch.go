@@ -0,0 +1,217 @@
+package slices
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RetryPolicy configures how a failed batch is retried by ForEachBatch.
+//
+// The delay before the n-th retry is InitialBackoff multiplied by
+// Multiplier to the power of n-1, capped at MaxBackoff if it is positive.
+// A random fraction of up to Jitter of the delay is subtracted from it,
+// drawn from the random source Source, to spread out concurrent retries.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts per batch, including
+	// the first one. If it is not positive, a batch is attempted once.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries if it is positive.
+	MaxBackoff time.Duration
+	// Multiplier is the factor by which the delay grows after each retry.
+	// If it is less than 1, 2 is used.
+	Multiplier float64
+	// Jitter is the fraction in [0, 1] up to which the delay is randomly
+	// reduced.
+	Jitter float64
+	// Retryable reports whether a batch failing with the error err is
+	// retried. If it is nil, all errors are retried.
+	Retryable func(err error) bool
+	// Source is the random source of the jitter. It is only used while
+	// holding a lock, so it does not have to be safe for concurrent use.
+	// If it is nil, the global source of math/rand is used.
+	Source Source
+}
+
+// BatchOptions configures how ForEachBatch processes the batches.
+type BatchOptions struct {
+	// Retry is the policy for retrying failed batches.
+	Retry RetryPolicy
+	// RateLimit is the maximum number of batches started per second. Only
+	// the first attempt of a batch counts towards the limit, retries are
+	// paced by the retry policy. If it is not positive, batches are not
+	// limited.
+	RateLimit float64
+	// Concurrency is the maximum number of batches processed concurrently.
+	// If it is not positive, batches are processed one at a time.
+	Concurrency int
+}
+
+// BatchFailure describes a batch which ultimately failed in ForEachBatch.
+type BatchFailure[E any] struct {
+	// Index is the index of the batch, as returned by ChunkedView.
+	Index int
+	// Batch is the batch of elements.
+	Batch []E
+	// Attempts is the number of times the batch was attempted, which is 0
+	// if the context was done before the batch was started.
+	Attempts int
+	// Err is the error of the last attempt or the error of the context.
+	Err error
+}
+
+// ForEachBatch splits the slice s into batches with the size n, like
+// ChunkedView, and calls the function fn for each batch. Batches for which
+// fn returns an error are retried according to opts.Retry, at most
+// opts.RateLimit batches are started per second and up to
+// opts.Concurrency batches are processed concurrently.
+//
+// It returns the batches which ultimately failed, ordered by their index.
+// Once the context ctx is done, no further attempts are made and all
+// remaining batches are reported as failed with the error of the context.
+//
+// If n is not positive, ForEachBatch will panic.
+func ForEachBatch[E any](ctx context.Context, s []E, n int, fn func(ctx context.Context, batch []E) error, opts BatchOptions) []BatchFailure[E] {
+	batches := ChunkedView(s, n)
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = 1
+	}
+	if workers > len(batches) {
+		workers = len(batches)
+	}
+
+	l := newLimiter(opts.RateLimit)
+	b := &backoff{policy: opts.Retry, src: sourceOrDefault(opts.Retry.Source)}
+	failures := make([]*BatchFailure[E], len(batches))
+	indices := make(chan int, len(batches))
+	for i := range batches {
+		indices <- i
+	}
+	close(indices)
+	parallel(workers, func(int) {
+		for i := range indices {
+			if err := l.wait(ctx); err != nil {
+				failures[i] = &BatchFailure[E]{i, batches[i], 0, err}
+				continue
+			}
+			attempts, err := retry(ctx, b, func() error { return fn(ctx, batches[i]) })
+			if err != nil {
+				failures[i] = &BatchFailure[E]{i, batches[i], attempts, err}
+			}
+		}
+	})
+
+	r := make([]BatchFailure[E], 0)
+	for _, f := range failures {
+		if f != nil {
+			r = append(r, *f)
+		}
+	}
+	return r
+}
+
+// retry calls the function fn until it succeeds or the retry policy of b
+// gives up, and returns the number of attempts along with the last error.
+func retry(ctx context.Context, b *backoff, fn func() error) (int, error) {
+	maxAttempts := b.policy.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return attempt, nil
+		}
+		if attempt == maxAttempts || (b.policy.Retryable != nil && !b.policy.Retryable(err)) {
+			return attempt, err
+		}
+		if sleep(ctx, b.delay(attempt)) != nil {
+			return attempt, err
+		}
+	}
+}
+
+// backoff computes the delays between retries of a retry policy.
+type backoff struct {
+	policy RetryPolicy
+	mu     sync.Mutex
+	src    Source
+}
+
+// delay returns the delay after the given attempt, starting at 1.
+func (b *backoff) delay(attempt int) time.Duration {
+	p := b.policy
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+	d := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	// Avoid overflowing time.Duration if the delay is not capped.
+	d = math.Min(d, math.MaxInt64)
+	if p.Jitter > 0 {
+		b.mu.Lock()
+		d -= d * math.Min(p.Jitter, 1) * float64N(b.src)
+		b.mu.Unlock()
+	}
+	if d >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
+
+// limiter spaces out events evenly to a maximum rate. A nil limiter does not
+// limit events.
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// newLimiter returns a limiter of rate events per second, or nil if rate is
+// not positive.
+func newLimiter(rate float64) *limiter {
+	if rate <= 0 {
+		return nil
+	}
+	return &limiter{interval: time.Duration(float64(time.Second) / rate)}
+}
+
+// wait blocks until the next event is allowed or the context ctx is done, in
+// which case the error of the context is returned.
+func (l *limiter) wait(ctx context.Context) error {
+	if l == nil {
+		return ctx.Err()
+	}
+	l.mu.Lock()
+	now := time.Now()
+	t := l.next
+	if t.Before(now) {
+		t = now
+	}
+	l.next = t.Add(l.interval)
+	l.mu.Unlock()
+	return sleep(ctx, t.Sub(now))
+}
+
+// sleep blocks for the duration d or until the context ctx is done, in which
+// case the error of the context is returned.
+func sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil || d <= 0 {
+		return err
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,168 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestForEachBatch(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7}
+	var mu sync.Mutex
+	batches := make([][]int, 0)
+	failures := ForEachBatch(context.Background(), s, 3, func(ctx context.Context, batch []int) error {
+		mu.Lock()
+		defer mu.Unlock()
+		batches = append(batches, clone(batch))
+		return nil
+	}, BatchOptions{})
+	assertEqual(t, []BatchFailure[int]{}, failures)
+	assertEqual(t, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}, batches)
+
+	failures = ForEachBatch(context.Background(), nil, 3, func(ctx context.Context, batch []int) error { return nil }, BatchOptions{})
+	assertEqual(t, []BatchFailure[int]{}, failures)
+
+	assertPanic(t, errInvalidSize, func() {
+		ForEachBatch(context.Background(), s, 0, func(ctx context.Context, batch []int) error { return nil }, BatchOptions{})
+	})
+}
+
+func TestForEachBatchRetry(t *testing.T) {
+	errTransient := errors.New("transient")
+	errPermanent := errors.New("permanent")
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	var mu sync.Mutex
+	attempts := make(map[int]int)
+	fn := func(ctx context.Context, batch []int) error {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts[batch[0]]++
+		switch {
+		case batch[0] == 3:
+			return errPermanent
+		case batch[0] == 5:
+			return errTransient
+		case attempts[batch[0]] < 3:
+			return errTransient
+		}
+		return nil
+	}
+	opts := BatchOptions{
+		Retry: RetryPolicy{
+			MaxAttempts:    4,
+			InitialBackoff: time.Millisecond,
+			Jitter:         0.5,
+			Retryable:      func(err error) bool { return errors.Is(err, errTransient) },
+			Source:         rand.New(rand.NewSource(1)),
+		},
+		Concurrency: 2,
+	}
+
+	failures := ForEachBatch(context.Background(), s, 2, fn, opts)
+	assertEqual(t, []BatchFailure[int]{
+		{Index: 1, Batch: []int{3, 4}, Attempts: 1, Err: errPermanent},
+		{Index: 2, Batch: []int{5, 6}, Attempts: 4, Err: errTransient},
+	}, failures)
+	assertEqual(t, map[int]int{1: 3, 3: 1, 5: 4, 7: 3}, attempts)
+
+	// Without a retry policy, each batch is attempted exactly once.
+	attempts = make(map[int]int)
+	failures = ForEachBatch(context.Background(), s, 2, fn, BatchOptions{Concurrency: 4})
+	assertEqual(t, []int{0, 1, 2, 3}, Map(failures, func(f BatchFailure[int]) int { return f.Index }))
+	assertEqual(t, map[int]int{1: 1, 3: 1, 5: 1, 7: 1}, attempts)
+}
+
+func TestForEachBatchConcurrency(t *testing.T) {
+	var running, peak int32
+	fn := func(ctx context.Context, batch []int) error {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	}
+
+	ForEachBatch(context.Background(), make([]int, 20), 1, fn, BatchOptions{Concurrency: 3})
+	if peak < 2 || peak > 3 {
+		t.Errorf("Test %s: Expected between 2 and 3 concurrent batches, got %d", t.Name(), peak)
+	}
+}
+
+func TestForEachBatchRateLimit(t *testing.T) {
+	start := time.Now()
+	failures := ForEachBatch(context.Background(), make([]int, 6), 1, func(ctx context.Context, batch []int) error {
+		return nil
+	}, BatchOptions{RateLimit: 100, Concurrency: 6})
+	assertEqual(t, 0, len(failures))
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Test %s: Expected 6 batches at 100 per second to take at least 50ms, took %v", t.Name(), elapsed)
+	}
+
+	// Retries are paced by the retry policy and not by the rate limit.
+	start = time.Now()
+	attempts := 0
+	failures = ForEachBatch(context.Background(), []int{1}, 1, func(ctx context.Context, batch []int) error {
+		if attempts++; attempts < 3 {
+			return errors.New("transient")
+		}
+		return nil
+	}, BatchOptions{Retry: RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}, RateLimit: 1})
+	assertEqual(t, 0, len(failures))
+	assertEqual(t, 3, attempts)
+	if elapsed := time.Since(start); elapsed >= 500*time.Millisecond {
+		t.Errorf("Test %s: Expected retries to not wait for the rate limit, took %v", t.Name(), elapsed)
+	}
+}
+
+func TestForEachBatchCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errFailed := errors.New("failed")
+	failures := ForEachBatch(ctx, []int{1, 2, 3, 4}, 1, func(ctx context.Context, batch []int) error {
+		if batch[0] == 2 {
+			cancel()
+			return errFailed
+		}
+		return nil
+	}, BatchOptions{Retry: RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour}})
+	assertEqual(t, []BatchFailure[int]{
+		{Index: 1, Batch: []int{2}, Attempts: 1, Err: errFailed},
+		{Index: 2, Batch: []int{3}, Attempts: 0, Err: context.Canceled},
+		{Index: 3, Batch: []int{4}, Attempts: 0, Err: context.Canceled},
+	}, failures)
+}
+
+func TestBackoffDelay(t *testing.T) {
+	b := &backoff{policy: RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}}
+	delays := Map([]int{1, 2, 3, 4, 5}, b.delay)
+	assertEqual(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second}, delays)
+
+	b.policy.Multiplier = 3
+	assertEqual(t, 900*time.Millisecond, b.delay(3))
+
+	b = &backoff{policy: RetryPolicy{InitialBackoff: time.Millisecond}}
+	assertEqual(t, time.Duration(math.MaxInt64), b.delay(45))
+	assertEqual(t, time.Duration(math.MaxInt64), b.delay(10000))
+	b.policy.Jitter = 0.5
+	b.src = rand.New(rand.NewSource(1))
+	if d := b.delay(10000); d < math.MaxInt64/2 {
+		t.Errorf("Test %s: Expected delay of at least half the maximum, got %v", t.Name(), d)
+	}
+
+	b = &backoff{policy: RetryPolicy{InitialBackoff: time.Second, Jitter: 0.25}, src: rand.New(rand.NewSource(1))}
+	for i := 0; i < 100; i++ {
+		if d := b.delay(1); d <= 750*time.Millisecond || d > time.Second {
+			t.Errorf("Test %s: Expected delay in (750ms, 1s], got %v", t.Name(), d)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package slices
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// FromChan receives all elements from the channel ch until it is closed and
+// returns them as a slice. If the context ctx is done before the channel is
+// closed, the elements received so far are returned along with the error
+// of the context.
+func FromChan[E any](ctx context.Context, ch <-chan E) ([]E, error) {
+	r := make([]E, 0)
+	for {
+		select {
+		case e, ok := <-ch:
+			if !ok {
+				return r, nil
+			}
+			r = append(r, e)
+		case <-ctx.Done():
+			return r, ctx.Err()
+		}
+	}
+}
+
+// ToChan returns a channel on which all elements of the slice s are sent in
+// order. The channel is closed after the last element has been sent or once
+// the context ctx is done.
+func ToChan[E any](ctx context.Context, s []E) <-chan E {
+	out := make(chan E)
+	go func() {
+		defer close(out)
+		for _, e := range s {
+			if !send(ctx, out, e) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// MapChan returns a channel on which the results of applying the function fn
+// to each element received from the channel in are sent. The channel is
+// closed once in is closed or the context ctx is done.
+func MapChan[E1, E2 any](ctx context.Context, in <-chan E1, fn func(e E1) E2) <-chan E2 {
+	out := make(chan E2)
+	go func() {
+		defer close(out)
+		for {
+			e, ok := receive(ctx, in)
+			if !ok || !send(ctx, out, fn(e)) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FilterChan returns a channel on which all elements received from the
+// channel in are sent, for which the function fn returns true. The channel is
+// closed once in is closed or the context ctx is done.
+func FilterChan[E any](ctx context.Context, in <-chan E, fn func(e E) bool) <-chan E {
+	out := make(chan E)
+	go func() {
+		defer close(out)
+		for {
+			e, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+			if fn(e) && !send(ctx, out, e) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// BatchChan returns a channel on which the elements received from the
+// channel in are sent in batches with the size n, like Chunked. If maxWait is
+// positive, a smaller batch is sent once maxWait has elapsed since its first
+// element was received. The last batch may be smaller as well. The channel is
+// closed once in is closed or the context ctx is done; a pending batch is
+// only sent in the former case.
+//
+// If n is not positive, BatchChan will panic.
+func BatchChan[E any](ctx context.Context, in <-chan E, n int, maxWait time.Duration) <-chan []E {
+	if n <= 0 {
+		panic(errInvalidSize)
+	}
+	out := make(chan []E)
+	go func() {
+		defer close(out)
+		batch := make([]E, 0, n)
+		// timeout is nil and thus blocks forever while no timer is running.
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, timeout = nil, nil
+			}
+			if len(batch) == 0 {
+				return true
+			}
+			b := batch
+			batch = make([]E, 0, n)
+			return send(ctx, out, b)
+		}
+		for {
+			select {
+			case e, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				batch = append(batch, e)
+				if len(batch) == n {
+					if !flush() {
+						return
+					}
+				} else if len(batch) == 1 && maxWait > 0 {
+					timer = time.NewTimer(maxWait)
+					timeout = timer.C
+				}
+			case <-timeout:
+				timer, timeout = nil, nil
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				if timer != nil {
+					timer.Stop()
+				}
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// MergeChans returns a channel on which all elements received from any of the
+// channels ins are sent, in the order they are received. The channel is
+// closed once all channels ins are closed or the context ctx is done.
+func MergeChans[E any](ctx context.Context, ins ...<-chan E) <-chan E {
+	out := make(chan E)
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+	for _, in := range ins {
+		go func(in <-chan E) {
+			defer wg.Done()
+			for {
+				e, ok := receive(ctx, in)
+				if !ok || !send(ctx, out, e) {
+					return
+				}
+			}
+		}(in)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanOut returns n channels which compete for the elements received from the
+// channel in, such that each element is sent on exactly one of them to
+// distribute work across consumers. The channels are closed once in is closed
+// or the context ctx is done.
+//
+// If n is not positive, FanOut will panic.
+func FanOut[E any](ctx context.Context, in <-chan E, n int) []<-chan E {
+	if n <= 0 {
+		panic(errInvalidSize)
+	}
+	outs := make([]<-chan E, n)
+	for i := range outs {
+		out := make(chan E)
+		outs[i] = out
+		go func() {
+			defer close(out)
+			for {
+				e, ok := receive(ctx, in)
+				if !ok || !send(ctx, out, e) {
+					return
+				}
+			}
+		}()
+	}
+	return outs
+}
+
+// send sends the element e on the channel ch and reports whether it was sent
+// before the context ctx was done.
+func send[E any](ctx context.Context, ch chan<- E, e E) bool {
+	select {
+	case ch <- e:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// receive receives an element from the channel ch and reports whether it was
+// received before the channel was closed or the context ctx was done.
+func receive[E any](ctx context.Context, ch <-chan E) (zeroValue E, _ bool) {
+	select {
+	case e, ok := <-ch:
+		return e, ok
+	case <-ctx.Done():
+		return zeroValue, false
+	}
+}
@@ -0,0 +1,160 @@
+package slices
+
+import (
+	"context"
+	"runtime"
+	"sort"
+	"testing"
+	"time"
+)
+
+// assertNoLeak checks that the number of goroutines returns to n.
+func assertNoLeak(t *testing.T, n int) {
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > n {
+		if time.Now().After(deadline) {
+			t.Errorf("Test %s: Expected %d goroutines, Received %d", t.Name(), n, runtime.NumGoroutine())
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	close(ch)
+	s, err := FromChan(context.Background(), ch)
+	assertNil(t, err)
+	assertEqual(t, []int{1, 2}, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s, err = FromChan(ctx, make(chan int))
+	assertEqual(t, context.Canceled, err)
+	assertEqual(t, []int{}, s)
+}
+
+func TestToChan(t *testing.T) {
+	n := runtime.NumGoroutine()
+	s, err := FromChan(context.Background(), ToChan(context.Background(), []int{1, 2, 3}))
+	assertNil(t, err)
+	assertEqual(t, []int{1, 2, 3}, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := ToChan(ctx, []int{1, 2, 3})
+	assertEqual(t, 1, <-ch)
+	cancel()
+	for range ch {
+	}
+	assertNoLeak(t, n)
+}
+
+func TestMapChan(t *testing.T) {
+	n := runtime.NumGoroutine()
+	ctx := context.Background()
+	s, err := FromChan(ctx, MapChan(ctx, ToChan(ctx, []int{1, 2, 3}), func(i int) int { return i * 2 }))
+	assertNil(t, err)
+	assertEqual(t, []int{2, 4, 6}, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := MapChan(ctx, make(chan int), func(i int) int { return i })
+	cancel()
+	_, ok := <-ch
+	assertEqual(t, false, ok)
+	assertNoLeak(t, n)
+}
+
+func TestFilterChan(t *testing.T) {
+	n := runtime.NumGoroutine()
+	ctx := context.Background()
+	s, err := FromChan(ctx, FilterChan(ctx, ToChan(ctx, []int{1, 2, 3, 4, 5}), func(i int) bool { return i > 2 }))
+	assertNil(t, err)
+	assertEqual(t, []int{3, 4, 5}, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := FilterChan(ctx, ToChan(ctx, []int{1, 2, 3}), func(i int) bool { return true })
+	cancel()
+	for range ch {
+	}
+	assertNoLeak(t, n)
+}
+
+func TestBatchChan(t *testing.T) {
+	n := runtime.NumGoroutine()
+	ctx := context.Background()
+	s, err := FromChan(ctx, BatchChan(ctx, ToChan(ctx, []int{1, 2, 3, 4, 5}), 2, 0))
+	assertNil(t, err)
+	assertEqual(t, [][]int{{1, 2}, {3, 4}, {5}}, s)
+
+	in := make(chan int)
+	out := BatchChan(ctx, in, 3, 10*time.Millisecond)
+	in <- 1
+	in <- 2
+	start := time.Now()
+	assertEqual(t, []int{1, 2}, <-out)
+	if time.Since(start) > time.Second {
+		t.Errorf("Test %s: Expected batch to be sent after max wait", t.Name())
+	}
+	in <- 3
+	in <- 4
+	in <- 5
+	assertEqual(t, []int{3, 4, 5}, <-out)
+	close(in)
+	_, ok := <-out
+	assertEqual(t, false, ok)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	in = make(chan int)
+	out = BatchChan(ctx, in, 3, time.Hour)
+	in <- 1
+	cancel()
+	_, ok = <-out
+	assertEqual(t, false, ok)
+	assertNoLeak(t, n)
+
+	assertPanic(t, errInvalidSize, func() { BatchChan(ctx, in, 0, 0) })
+}
+
+func TestMergeChans(t *testing.T) {
+	n := runtime.NumGoroutine()
+	ctx := context.Background()
+	s, err := FromChan(ctx, MergeChans(ctx, ToChan(ctx, []int{1, 2}), ToChan(ctx, []int{3}), ToChan(ctx, []int{})))
+	assertNil(t, err)
+	sort.Ints(s)
+	assertEqual(t, []int{1, 2, 3}, s)
+
+	s, err = FromChan(ctx, MergeChans[int](ctx))
+	assertNil(t, err)
+	assertEqual(t, []int{}, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := MergeChans(ctx, make(chan int), make(chan int))
+	cancel()
+	_, ok := <-ch
+	assertEqual(t, false, ok)
+	assertNoLeak(t, n)
+}
+
+func TestFanOut(t *testing.T) {
+	n := runtime.NumGoroutine()
+	ctx := context.Background()
+	outs := FanOut(ctx, ToChan(ctx, []int{1, 2, 3, 4, 5, 6}), 3)
+	assertEqual(t, 3, len(outs))
+	s, err := FromChan(ctx, MergeChans(ctx, outs...))
+	assertNil(t, err)
+	sort.Ints(s)
+	assertEqual(t, []int{1, 2, 3, 4, 5, 6}, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	outs = FanOut(ctx, make(chan int), 2)
+	cancel()
+	for _, out := range outs {
+		_, ok := <-out
+		assertEqual(t, false, ok)
+	}
+	assertNoLeak(t, n)
+
+	assertPanic(t, errInvalidSize, func() { FanOut(ctx, make(chan int), 0) })
+}
@@ -0,0 +1,177 @@
+package slices
+
+// Seq is a generator which calls the function yield with each slice it
+// generates until yield returns false, thus large spaces can be enumerated
+// lazily. It is compatible with iter.Seq, so it may be ranged over with
+// Go 1.23 or later.
+//
+// If a generator is created with reuse set to true, it overwrites the same
+// buffer for each slice, which must be copied to be retained. Otherwise
+// each slice is newly allocated.
+type Seq[E any] func(yield func(s []E) bool)
+
+// Permutations returns a generator of all permutations of the elements of
+// the slice s in lexicographic order of their positions. Elements are
+// treated as unique based on their position, not their value.
+func Permutations[E any](s []E, reuse bool) Seq[E] {
+	return func(yield func(p []E) bool) {
+		n := len(s)
+		idx := make([]int, n)
+		for i := range idx {
+			idx[i] = i
+		}
+		var buf []E
+		for {
+			buf = gather(buf, s, idx, reuse)
+			if !yield(buf) {
+				return
+			}
+			i := n - 2
+			for i >= 0 && idx[i] >= idx[i+1] {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			j := n - 1
+			for idx[j] <= idx[i] {
+				j--
+			}
+			idx[i], idx[j] = idx[j], idx[i]
+			ReverseInPlace(idx[i+1:])
+		}
+	}
+}
+
+// Combinations returns a generator of all combinations of k elements of the
+// slice s in lexicographic order of their positions. Elements are treated as
+// unique based on their position, not their value. If k is negative or
+// greater than the length of s, no combinations are generated.
+func Combinations[E any](s []E, k int, reuse bool) Seq[E] {
+	return func(yield func(c []E) bool) {
+		n := len(s)
+		if k < 0 || k > n {
+			return
+		}
+		idx := make([]int, k)
+		for i := range idx {
+			idx[i] = i
+		}
+		var buf []E
+		for {
+			buf = gather(buf, s, idx, reuse)
+			if !yield(buf) {
+				return
+			}
+			i := k - 1
+			for i >= 0 && idx[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			idx[i]++
+			for j := i + 1; j < k; j++ {
+				idx[j] = idx[j-1] + 1
+			}
+		}
+	}
+}
+
+// CombinationsWithReplacement returns a generator of all combinations of k
+// elements of the slice s allowing individual elements to be repeated, in
+// lexicographic order of their positions. If k is negative, or s is empty
+// and k is positive, no combinations are generated.
+func CombinationsWithReplacement[E any](s []E, k int, reuse bool) Seq[E] {
+	return func(yield func(c []E) bool) {
+		n := len(s)
+		if k < 0 || (n == 0 && k > 0) {
+			return
+		}
+		idx := make([]int, k)
+		var buf []E
+		for {
+			buf = gather(buf, s, idx, reuse)
+			if !yield(buf) {
+				return
+			}
+			i := k - 1
+			for i >= 0 && idx[i] == n-1 {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			idx[i]++
+			for j := i + 1; j < k; j++ {
+				idx[j] = idx[i]
+			}
+		}
+	}
+}
+
+// CartesianProduct returns a generator of all tuples containing one element
+// of each of the slices s, where the last slice varies fastest. If any of the
+// slices is empty, no tuples are generated. If no slices are given, a single
+// empty tuple is generated.
+func CartesianProduct[E any](s [][]E, reuse bool) Seq[E] {
+	return func(yield func(t []E) bool) {
+		if Any(s, func(e []E) bool { return len(e) == 0 }) {
+			return
+		}
+		idx := make([]int, len(s))
+		var buf []E
+		for {
+			if !reuse || buf == nil {
+				buf = make([]E, len(s))
+			}
+			for i, j := range idx {
+				buf[i] = s[i][j]
+			}
+			if !yield(buf) {
+				return
+			}
+			i := len(s) - 1
+			for i >= 0 && idx[i] == len(s[i])-1 {
+				idx[i] = 0
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			idx[i]++
+		}
+	}
+}
+
+// PowerSet returns a generator of all subsets of the elements of the slice
+// s, ordered by their size and then lexicographically by the positions of
+// their elements, starting with the empty set. If reuse is true, a buffer
+// is shared by the subsets of each size.
+func PowerSet[E any](s []E, reuse bool) Seq[E] {
+	return func(yield func(c []E) bool) {
+		for k := 0; k <= len(s); k++ {
+			done := false
+			Combinations(s, k, reuse)(func(c []E) bool {
+				done = !yield(c)
+				return !done
+			})
+			if done {
+				return
+			}
+		}
+	}
+}
+
+// gather fills the buffer buf with the elements of the slice s at the
+// indices idx. A new buffer is allocated unless reuse is true and buf
+// has already been allocated.
+func gather[E any](buf, s []E, idx []int, reuse bool) []E {
+	if !reuse || buf == nil {
+		buf = make([]E, len(idx))
+	}
+	for i, j := range idx {
+		buf[i] = s[j]
+	}
+	return buf
+}
@@ -0,0 +1,141 @@
+package slices
+
+import (
+	"testing"
+	"unsafe"
+)
+
+// collect returns copies of all slices yielded by the generator seq.
+func collect[E any](seq Seq[E]) [][]E {
+	r := make([][]E, 0)
+	seq(func(e []E) bool {
+		r = append(r, clone(e))
+		return true
+	})
+	return r
+}
+
+// take returns the first n slices yielded by the generator seq.
+func take[E any](seq Seq[E], n int) [][]E {
+	r := make([][]E, 0)
+	seq(func(e []E) bool {
+		r = append(r, e)
+		return len(r) < n
+	})
+	return r
+}
+
+func TestPermutations(t *testing.T) {
+	tests := []struct {
+		s []int
+		e [][]int
+	}{
+		{s: nil, e: [][]int{{}}},
+		{s: []int{1}, e: [][]int{{1}}},
+		{s: []int{1, 2, 3}, e: [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}},
+		{s: []int{1, 1}, e: [][]int{{1, 1}, {1, 1}}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, collect(Permutations(test.s, true)))
+		assertEqual(t, test.e, collect(Permutations(test.s, false)))
+	}
+
+	assertEqual(t, 720, len(collect(Permutations([]int{1, 2, 3, 4, 5, 6}, true))))
+	assertEqual(t, [][]int{{1, 2, 3}, {1, 3, 2}}, take(Permutations([]int{1, 2, 3}, false), 2))
+
+	p := take(Permutations([]int{1, 2, 3}, true), 2)
+	assertEqual(t, unsafe.Pointer(&p[0][0]), unsafe.Pointer(&p[1][0]))
+}
+
+func TestCombinations(t *testing.T) {
+	tests := []struct {
+		s []int
+		k int
+		e [][]int
+	}{
+		{s: nil, k: 0, e: [][]int{{}}},
+		{s: nil, k: 1, e: [][]int{}},
+		{s: []int{1, 2, 3}, k: -1, e: [][]int{}},
+		{s: []int{1, 2, 3}, k: 4, e: [][]int{}},
+		{s: []int{1, 2, 3}, k: 3, e: [][]int{{1, 2, 3}}},
+		{s: []int{1, 2, 3, 4}, k: 2, e: [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, collect(Combinations(test.s, test.k, true)))
+		assertEqual(t, test.e, collect(Combinations(test.s, test.k, false)))
+	}
+
+	assertEqual(t, 252, len(collect(Combinations([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, 5, true))))
+
+	c := take(Combinations([]int{1, 2, 3}, 2, false), 2)
+	assertEqual(t, [][]int{{1, 2}, {1, 3}}, c)
+	if unsafe.Pointer(&c[0][0]) == unsafe.Pointer(&c[1][0]) {
+		t.Errorf("Test %s: Expected s1 and s2 to not be the same slice", t.Name())
+	}
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	tests := []struct {
+		s []int
+		k int
+		e [][]int
+	}{
+		{s: nil, k: 0, e: [][]int{{}}},
+		{s: nil, k: 2, e: [][]int{}},
+		{s: []int{1, 2}, k: -1, e: [][]int{}},
+		{s: []int{1, 2, 3}, k: 2, e: [][]int{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}}},
+		{s: []int{1, 2}, k: 3, e: [][]int{{1, 1, 1}, {1, 1, 2}, {1, 2, 2}, {2, 2, 2}}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, collect(CombinationsWithReplacement(test.s, test.k, true)))
+		assertEqual(t, test.e, collect(CombinationsWithReplacement(test.s, test.k, false)))
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	tests := []struct {
+		s [][]string
+		e [][]string
+	}{
+		{s: nil, e: [][]string{{}}},
+		{s: [][]string{{"a", "b"}, {}}, e: [][]string{}},
+		{s: [][]string{{"a", "b"}}, e: [][]string{{"a"}, {"b"}}},
+		{
+			s: [][]string{{"linux", "darwin"}, {"amd64", "arm64"}, {"cgo"}},
+			e: [][]string{
+				{"linux", "amd64", "cgo"},
+				{"linux", "arm64", "cgo"},
+				{"darwin", "amd64", "cgo"},
+				{"darwin", "arm64", "cgo"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, collect(CartesianProduct(test.s, true)))
+		assertEqual(t, test.e, collect(CartesianProduct(test.s, false)))
+	}
+
+	assertEqual(t, [][]string{{"a", "c"}}, take(CartesianProduct([][]string{{"a", "b"}, {"c", "d"}}, false), 1))
+}
+
+func TestPowerSet(t *testing.T) {
+	tests := []struct {
+		s []int
+		e [][]int
+	}{
+		{s: nil, e: [][]int{{}}},
+		{s: []int{1, 2, 3}, e: [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, collect(PowerSet(test.s, true)))
+		assertEqual(t, test.e, collect(PowerSet(test.s, false)))
+	}
+
+	assertEqual(t, 1024, len(collect(PowerSet(make([]int, 10), true))))
+	assertEqual(t, [][]int{{}, {1}, {2}}, take(PowerSet([]int{1, 2, 3}, false), 3))
+}
@@ -0,0 +1,332 @@
+package slices
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EditOp is the kind of an edit operation.
+type EditOp int
+
+const (
+	// EditEqual keeps elements present in both sequences.
+	EditEqual EditOp = iota
+	// EditInsert inserts elements only present in the second sequence.
+	EditInsert
+	// EditDelete deletes elements only present in the first sequence.
+	EditDelete
+)
+
+// String returns the name of the edit operation.
+func (op EditOp) String() string {
+	switch op {
+	case EditEqual:
+		return "equal"
+	case EditInsert:
+		return "insert"
+	case EditDelete:
+		return "delete"
+	}
+	return fmt.Sprintf("EditOp(%d)", int(op))
+}
+
+// Edit is a hunk of an edit script, applying the operation Op to a run of
+// consecutive elements.
+type Edit[E any] struct {
+	Op       EditOp
+	Elements []E
+}
+
+// Diff returns a minimal edit script transforming the slice a into the
+// slice b, as a sequence of hunks of equal, inserted and deleted elements.
+// Adjacent hunks always have different operations.
+//
+// It uses the linear space refinement of Myers' algorithm and runs in
+// O((n+m)d) time and O(n+m) space, where d is the number of inserted and
+// deleted elements.
+func Diff[E comparable](a, b []E) []Edit[E] {
+	return DiffFunc(a, b, func(x, y E) bool { return x == y })
+}
+
+// DiffFunc returns a minimal edit script transforming the slice a into the
+// slice b, where elements are considered equal if the function eq returns
+// true, as a sequence of hunks of equal, inserted and deleted elements.
+// Adjacent hunks always have different operations. The elements of equal
+// hunks are taken from the slice a.
+//
+// It uses the linear space refinement of Myers' algorithm and runs in
+// O((n+m)d) time and O(n+m) space, where d is the number of inserted and
+// deleted elements.
+func DiffFunc[E any](a, b []E, eq func(x, y E) bool) []Edit[E] {
+	r := make([]Edit[E], 0)
+	i, j := 0, 0
+	for _, op := range myers(a, b, eq) {
+		var e E
+		switch op {
+		case EditInsert:
+			e = b[j]
+			j++
+		case EditDelete:
+			e = a[i]
+			i++
+		default:
+			e = a[i]
+			i++
+			j++
+		}
+		if n := len(r); n > 0 && r[n-1].Op == op {
+			r[n-1].Elements = append(r[n-1].Elements, e)
+			continue
+		}
+		r = append(r, Edit[E]{op, []E{e}})
+	}
+	return r
+}
+
+// Patch applies the edit script to the slice a and returns the resulting
+// newly allocated slice.
+//
+// The equal and deleted elements of the script are verified against the
+// slice a. If they do not match or the script does not cover the whole
+// slice, an error is returned.
+func Patch[E comparable](a []E, script []Edit[E]) ([]E, error) {
+	return PatchFunc(a, script, func(x, y E) bool { return x == y })
+}
+
+// PatchFunc applies the edit script to the slice a and returns the resulting
+// newly allocated slice, where elements are considered equal if the function
+// eq returns true.
+//
+// The equal and deleted elements of the script are verified against the
+// slice a. If they do not match or the script does not cover the whole
+// slice, an error is returned.
+func PatchFunc[E any](a []E, script []Edit[E], eq func(x, y E) bool) ([]E, error) {
+	r := make([]E, 0, len(a))
+	i := 0
+	for _, e := range script {
+		if e.Op == EditInsert {
+			r = append(r, e.Elements...)
+			continue
+		}
+		if len(a)-i < len(e.Elements) {
+			return nil, errPatchMismatch
+		}
+		for j, v := range e.Elements {
+			if !eq(a[i+j], v) {
+				return nil, errPatchMismatch
+			}
+		}
+		if e.Op == EditEqual {
+			r = append(r, a[i:i+len(e.Elements)]...)
+		}
+		i += len(e.Elements)
+	}
+	if i != len(a) {
+		return nil, errPatchMismatch
+	}
+	return r, nil
+}
+
+// UnifiedDiff returns the differences between the lines a and b in the
+// unified diff format, with the given number of unchanged context lines
+// around each change. Each hunk starts with a header of the form
+// "@@ -l,s +l,s @@". If the lines are equal, the result is empty.
+func UnifiedDiff(a, b []string, context int) string {
+	if context < 0 {
+		context = 0
+	}
+	ops := myers(a, b, func(x, y string) bool { return x == y })
+	var sb strings.Builder
+	for start := 0; start < len(ops); {
+		// Find the next change and extend the hunk until the gap of equal
+		// lines to the following change exceeds twice the context.
+		first := start
+		for first < len(ops) && ops[first] == EditEqual {
+			first++
+		}
+		if first == len(ops) {
+			break
+		}
+		last := first
+		for k := first; k < len(ops); k++ {
+			if ops[k] != EditEqual {
+				last = k
+			} else if k-last > 2*context {
+				break
+			}
+		}
+		from := first - context
+		if from < start {
+			from = start
+		}
+		to := last + context + 1
+		if to > len(ops) {
+			to = len(ops)
+		}
+		writeHunk(&sb, a, b, ops, from, to)
+		start = to
+	}
+	return sb.String()
+}
+
+// writeHunk writes the unified diff hunk of the operations ops[from:to]
+// between the lines a and b to sb.
+func writeHunk(sb *strings.Builder, a, b []string, ops []EditOp, from, to int) {
+	i, j := 0, 0
+	for _, op := range ops[:from] {
+		if op != EditInsert {
+			i++
+		}
+		if op != EditDelete {
+			j++
+		}
+	}
+	n := Count(ops[from:to], func(op EditOp) bool { return op != EditInsert })
+	m := Count(ops[from:to], func(op EditOp) bool { return op != EditDelete })
+	fmt.Fprintf(sb, "@@ -%s +%s @@\n", hunkRange(i, int(n)), hunkRange(j, int(m)))
+	for _, op := range ops[from:to] {
+		switch op {
+		case EditEqual:
+			sb.WriteString(" " + a[i] + "\n")
+			i++
+			j++
+		case EditDelete:
+			sb.WriteString("-" + a[i] + "\n")
+			i++
+		case EditInsert:
+			sb.WriteString("+" + b[j] + "\n")
+			j++
+		}
+	}
+}
+
+// hunkRange returns the range of a unified diff hunk header for n lines
+// starting after the first i lines.
+func hunkRange(i, n int) string {
+	switch n {
+	case 0:
+		return fmt.Sprintf("%d,0", i)
+	case 1:
+		return fmt.Sprintf("%d", i+1)
+	}
+	return fmt.Sprintf("%d,%d", i+1, n)
+}
+
+// myers returns a minimal sequence of edit operations transforming the slice
+// a into the slice b, with one operation per element.
+func myers[E any](a, b []E, eq func(x, y E) bool) []EditOp {
+	return myersAppend(make([]EditOp, 0, len(a)+len(b)), a, b, eq)
+}
+
+// myersAppend appends a minimal sequence of edit operations transforming the
+// slice a into the slice b to ops, using the linear space refinement of
+// Myers' algorithm: the slices are split at the middle snake of a minimal
+// edit script and both halves are diffed recursively.
+func myersAppend[E any](ops []EditOp, a, b []E, eq func(x, y E) bool) []EditOp {
+	// Strip the common prefix and suffix, which are part of every minimal
+	// edit script, to reduce the size of the search.
+	p := 0
+	for p < len(a) && p < len(b) && eq(a[p], b[p]) {
+		p++
+	}
+	q := 0
+	for q < len(a)-p && q < len(b)-p && eq(a[len(a)-1-q], b[len(b)-1-q]) {
+		q++
+	}
+	for k := 0; k < p; k++ {
+		ops = append(ops, EditEqual)
+	}
+	a, b = a[p:len(a)-q], b[p:len(b)-q]
+	switch {
+	case len(a) == 0:
+		for range b {
+			ops = append(ops, EditInsert)
+		}
+	case len(b) == 0:
+		for range a {
+			ops = append(ops, EditDelete)
+		}
+	default:
+		x, y := myersSplit(a, b, eq)
+		ops = myersAppend(ops, a[:x], b[:y], eq)
+		ops = myersAppend(ops, a[x:], b[y:], eq)
+	}
+	for k := 0; k < q; k++ {
+		ops = append(ops, EditEqual)
+	}
+	return ops
+}
+
+// myersSplit returns the point (x, y) at which a minimal edit script
+// between the non-empty slices a and b without a common prefix or suffix
+// can be split. It searches the furthest reaching paths forward from the
+// start and backward from the end simultaneously until they overlap, which
+// happens after half the number of edits.
+func myersSplit[E any](a, b []E, eq func(x, y E) bool) (int, int) {
+	n, m := len(a), len(b)
+	maxD := (n+m+1)/2 + 1
+	off := maxD
+	// vf holds the furthest x of the forward paths and vb the furthest
+	// distance from the end of the backward paths on each diagonal.
+	vf, vb := make([]int, 2*maxD+2), make([]int, 2*maxD+2)
+	for i := range vf {
+		vf[i], vb[i] = -1, -1
+	}
+	vf[off+1], vb[off+1] = 0, 0
+	delta := n - m
+	odd := delta%2 != 0
+	// The diagonals which left the edit graph are excluded from the search.
+	fStart, fEnd, bStart, bEnd := 0, 0, 0, 0
+	for d := 0; d < maxD; d++ {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && vf[off+k-1] < vf[off+k+1]) {
+				x = vf[off+k+1]
+			} else {
+				x = vf[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && eq(a[x], b[y]) {
+				x++
+				y++
+			}
+			vf[off+k] = x
+			switch {
+			case x > n:
+				fEnd += 2
+			case y > m:
+				fStart += 2
+			case odd:
+				if kb := off + delta - k; kb >= 0 && kb < len(vb) && vb[kb] != -1 && x >= n-vb[kb] {
+					return x, y
+				}
+			}
+		}
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && vb[off+k-1] < vb[off+k+1]) {
+				x = vb[off+k+1]
+			} else {
+				x = vb[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && eq(a[n-1-x], b[m-1-y]) {
+				x++
+				y++
+			}
+			vb[off+k] = x
+			switch {
+			case x > n:
+				bEnd += 2
+			case y > m:
+				bStart += 2
+			case !odd:
+				if kf := off + delta - k; kf >= 0 && kf < len(vf) && vf[kf] != -1 && vf[kf] >= n-x {
+					return vf[kf], vf[kf] - (kf - off)
+				}
+			}
+		}
+	}
+	// Not reached, the paths overlap after at most half the number of edits.
+	return n, 0
+}
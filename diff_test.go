@@ -0,0 +1,153 @@
+package slices
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// lcsLen returns the length of the longest common subsequence of a and b.
+func lcsLen(a, b []int) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			switch {
+			case a[i-1] == b[j-1]:
+				dp[i][j] = dp[i-1][j-1] + 1
+			case dp[i-1][j] > dp[i][j-1]:
+				dp[i][j] = dp[i-1][j]
+			default:
+				dp[i][j] = dp[i][j-1]
+			}
+		}
+	}
+	return dp[len(a)][len(b)]
+}
+
+func TestEditOpString(t *testing.T) {
+	assertEqual(t, "equal", EditEqual.String())
+	assertEqual(t, "insert", EditInsert.String())
+	assertEqual(t, "delete", EditDelete.String())
+	assertEqual(t, "EditOp(7)", EditOp(7).String())
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		a, b string
+		e    []Edit[byte]
+	}{
+		{a: "", b: "", e: []Edit[byte]{}},
+		{a: "abc", b: "abc", e: []Edit[byte]{{EditEqual, []byte("abc")}}},
+		{a: "", b: "abc", e: []Edit[byte]{{EditInsert, []byte("abc")}}},
+		{a: "abc", b: "", e: []Edit[byte]{{EditDelete, []byte("abc")}}},
+		{a: "abcd", b: "abxd", e: []Edit[byte]{{EditEqual, []byte("ab")}, {EditDelete, []byte("c")}, {EditInsert, []byte("x")}, {EditEqual, []byte("d")}}},
+		{a: "abc", b: "aXbc", e: []Edit[byte]{{EditEqual, []byte("a")}, {EditInsert, []byte("X")}, {EditEqual, []byte("bc")}}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, Diff([]byte(test.a), []byte(test.b)))
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		a := Map(make([]int, rnd.Intn(30)), func(int) int { return rnd.Intn(4) })
+		b := Map(make([]int, rnd.Intn(30)), func(int) int { return rnd.Intn(4) })
+		script := Diff(a, b)
+
+		edits := SumOf(script, func(e Edit[int]) int {
+			if e.Op == EditEqual {
+				return 0
+			}
+			return len(e.Elements)
+		})
+		assertEqual(t, len(a)+len(b)-2*lcsLen(a, b), edits)
+
+		r, err := Patch(a, script)
+		assertNil(t, err)
+		assertEqual(t, b, r)
+	}
+
+	// Slices without common elements need the maximum number of edits.
+	a := Map(make([]int, 5000), func(i int) int { return i })
+	b := Map(make([]int, 5000), func(i int) int { return -i - 1 })
+	assertEqual(t, []Edit[int]{{EditDelete, a}, {EditInsert, b}}, Diff(a, b))
+}
+
+func TestDiffFunc(t *testing.T) {
+	a := []string{"Alpha", "beta", "Gamma"}
+	b := []string{"alpha", "Beta", "delta"}
+	assertEqual(t, []Edit[string]{
+		{EditEqual, []string{"Alpha", "beta"}},
+		{EditDelete, []string{"Gamma"}},
+		{EditInsert, []string{"delta"}},
+	}, DiffFunc(a, b, strings.EqualFold))
+}
+
+func TestPatch(t *testing.T) {
+	a := []int{1, 2, 3, 4}
+	script := Diff(a, []int{1, 3, 4, 5})
+
+	r, err := Patch(a, script)
+	assertNil(t, err)
+	assertEqual(t, []int{1, 3, 4, 5}, r)
+
+	_, err = Patch([]int{1, 9, 3, 4}, script)
+	assertEqual(t, errPatchMismatch, err)
+	_, err = Patch([]int{0, 2, 3, 4}, script)
+	assertEqual(t, errPatchMismatch, err)
+	_, err = Patch([]int{1, 2, 3}, script)
+	assertEqual(t, errPatchMismatch, err)
+	_, err = Patch([]int{1, 2, 3, 4, 5}, script)
+	assertEqual(t, errPatchMismatch, err)
+
+	r, err = Patch(nil, []Edit[int]{{EditInsert, []int{1}}})
+	assertNil(t, err)
+	assertEqual(t, []int{1}, r)
+}
+
+func TestPatchFunc(t *testing.T) {
+	script := []Edit[string]{{EditEqual, []string{"a"}}, {EditDelete, []string{"B"}}}
+	r, err := PatchFunc([]string{"A", "b"}, script, strings.EqualFold)
+	assertNil(t, err)
+	assertEqual(t, []string{"A"}, r)
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	lines := func(s string) []string { return strings.Split(s, " ") }
+
+	tests := []struct {
+		a, b    []string
+		context int
+		e       string
+	}{
+		{a: lines("a b c"), b: lines("a b c"), context: 3, e: ""},
+		{
+			a:       lines("a b c d e f g h i j"),
+			b:       lines("a b C d e f g h i j k"),
+			context: 1,
+			e: "@@ -2,3 +2,3 @@\n" +
+				" b\n-c\n+C\n d\n" +
+				"@@ -10 +10,2 @@\n" +
+				" j\n+k\n",
+		},
+		{
+			a:       lines("a b c d e"),
+			b:       lines("X b c d Y"),
+			context: 2,
+			e:       "@@ -1,5 +1,5 @@\n-a\n+X\n b\n c\n d\n-e\n+Y\n",
+		},
+		{
+			a:       nil,
+			b:       lines("a"),
+			context: 3,
+			e:       "@@ -0,0 +1 @@\n+a\n",
+		},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, UnifiedDiff(test.a, test.b, test.context))
+	}
+}
@@ -0,0 +1,197 @@
+package slices
+
+// EditCosts holds the costs of the edit operations used to compute the edit
+// distance between two slices. Transpose is only used by the Damerau
+// variants.
+type EditCosts struct {
+	Insert, Delete, Substitute, Transpose int
+}
+
+// unitCosts are the costs of the classic Levenshtein and Damerau distances.
+var unitCosts = EditCosts{Insert: 1, Delete: 1, Substitute: 1, Transpose: 1}
+
+// EditDistance returns the Levenshtein distance between the slices a and b,
+// i.e. the minimal number of insertions, deletions and substitutions of
+// single elements required to transform a into b.
+//
+// It runs in O(n·m) time and O(m) space.
+func EditDistance[E comparable](a, b []E) int {
+	return EditDistanceWithCosts(a, b, unitCosts)
+}
+
+// EditDistanceWithCosts returns the minimal total cost of insertions,
+// deletions and substitutions of single elements required to transform the
+// slice a into the slice b, using the given costs.
+//
+// It runs in O(n·m) time and O(m) space.
+func EditDistanceWithCosts[E comparable](a, b []E, c EditCosts) int {
+	prev, curr := make([]int, len(b)+1), make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j * c.Insert
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i * c.Delete
+		for j := 1; j <= len(b); j++ {
+			curr[j] = editStep(prev, curr, j, a[i-1] == b[j-1], c)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// DamerauEditDistance returns the optimal string alignment distance between
+// the slices a and b, i.e. the minimal number of insertions, deletions,
+// substitutions and transpositions of adjacent elements required to
+// transform a into b, where no element is edited more than once.
+//
+// It runs in O(n·m) time and O(m) space.
+func DamerauEditDistance[E comparable](a, b []E) int {
+	return DamerauEditDistanceWithCosts(a, b, unitCosts)
+}
+
+// DamerauEditDistanceWithCosts returns the minimal total cost of insertions,
+// deletions, substitutions and transpositions of adjacent elements required
+// to transform the slice a into the slice b, where no element is edited more
+// than once, using the given costs.
+//
+// It runs in O(n·m) time and O(m) space.
+func DamerauEditDistanceWithCosts[E comparable](a, b []E, c EditCosts) int {
+	pprev, prev, curr := make([]int, len(b)+1), make([]int, len(b)+1), make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j * c.Insert
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i * c.Delete
+		for j := 1; j <= len(b); j++ {
+			curr[j] = editStep(prev, curr, j, a[i-1] == b[j-1], c)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				if t := pprev[j-2] + c.Transpose; t < curr[j] {
+					curr[j] = t
+				}
+			}
+		}
+		pprev, prev, curr = prev, curr, pprev
+	}
+	return prev[len(b)]
+}
+
+// editStep returns the minimal cost of the cell j of the current row of the
+// edit distance matrix, given the previous row and the preceding cells.
+func editStep(prev, curr []int, j int, equal bool, c EditCosts) int {
+	d := prev[j-1]
+	if !equal {
+		d += c.Substitute
+	}
+	if v := prev[j] + c.Delete; v < d {
+		d = v
+	}
+	if v := curr[j-1] + c.Insert; v < d {
+		d = v
+	}
+	return d
+}
+
+// LongestCommonSubsequence returns a longest sequence of elements which
+// occur in both slices a and b in the same relative order, along with pairs
+// of the indices of each of its elements in a and b respectively.
+//
+// It uses Hirschberg's algorithm and runs in O(n·m) time and O(n+m) space.
+func LongestCommonSubsequence[E comparable](a, b []E) ([]E, []Pair[int, int]) {
+	idx := make([]Pair[int, int], 0)
+	idx = hirschberg(a, b, 0, 0, idx)
+	return Map(idx, func(p Pair[int, int]) E { return a[p.First] }), idx
+}
+
+// hirschberg appends the index pairs of a longest common subsequence of the
+// slices a and b, offset by i and j, to r.
+func hirschberg[E comparable](a, b []E, i, j int, r []Pair[int, int]) []Pair[int, int] {
+	switch {
+	case len(a) == 0 || len(b) == 0:
+		return r
+	case len(a) == 1:
+		if k := Index(b, a[0]); k >= 0 {
+			r = append(r, Pair[int, int]{i, j + k})
+		}
+		return r
+	}
+	mid := len(a) / 2
+	fwd := lcsLengths(a[:mid], b, false)
+	bwd := lcsLengths(a[mid:], b, true)
+	k, best := 0, -1
+	for x := 0; x <= len(b); x++ {
+		if l := fwd[x] + bwd[len(b)-x]; l > best {
+			k, best = x, l
+		}
+	}
+	r = hirschberg(a[:mid], b[:k], i, j, r)
+	return hirschberg(a[mid:], b[k:], i+mid, j+k, r)
+}
+
+// lcsLengths returns the lengths of the longest common subsequences of the
+// slice a and each prefix of the slice b. If reverse is true, both slices
+// are processed from the end, yielding the lengths for each suffix of b.
+func lcsLengths[E comparable](a, b []E, reverse bool) []int {
+	prev, curr := make([]int, len(b)+1), make([]int, len(b)+1)
+	for i := 1; i <= len(a); i++ {
+		x := a[i-1]
+		if reverse {
+			x = a[len(a)-i]
+		}
+		for j := 1; j <= len(b); j++ {
+			y := b[j-1]
+			if reverse {
+				y = b[len(b)-j]
+			}
+			switch {
+			case x == y:
+				curr[j] = prev[j-1] + 1
+			case prev[j] > curr[j-1]:
+				curr[j] = prev[j]
+			default:
+				curr[j] = curr[j-1]
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev
+}
+
+// LongestIncreasingSubsequence returns a longest sequence of elements of the
+// slice s in their original order, where each element is strictly greater
+// than the previous one according to the function less, along with the
+// indices of its elements in s.
+//
+// It uses patience sorting and runs in O(n log n) time and O(n) space.
+func LongestIncreasingSubsequence[E any](s []E, less func(a, b E) bool) ([]E, []int) {
+	// tails[k] is the index of the smallest tail of all increasing
+	// subsequences of length k+1, prev links each index to its predecessor.
+	tails := make([]int, 0)
+	prev := make([]int, len(s))
+	for i, e := range s {
+		lo, hi := 0, len(tails)
+		for lo < hi {
+			m := int(uint(lo+hi) >> 1)
+			if less(s[tails[m]], e) {
+				lo = m + 1
+			} else {
+				hi = m
+			}
+		}
+		prev[i] = -1
+		if lo > 0 {
+			prev[i] = tails[lo-1]
+		}
+		if lo == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[lo] = i
+		}
+	}
+	idx := make([]int, len(tails))
+	if len(tails) > 0 {
+		for k, i := len(tails)-1, tails[len(tails)-1]; k >= 0; k, i = k-1, prev[i] {
+			idx[k] = i
+		}
+	}
+	return Map(idx, func(i int) E { return s[i] }), idx
+}
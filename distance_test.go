@@ -0,0 +1,136 @@
+package slices
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		d    int
+	}{
+		{a: "", b: "", d: 0},
+		{a: "abc", b: "", d: 3},
+		{a: "", b: "abc", d: 3},
+		{a: "kitten", b: "sitting", d: 3},
+		{a: "flaw", b: "lawn", d: 2},
+		{a: "ca", b: "ac", d: 2},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.d, EditDistance([]byte(test.a), []byte(test.b)))
+		assertEqual(t, test.d, EditDistance([]byte(test.b), []byte(test.a)))
+	}
+}
+
+func TestEditDistanceWithCosts(t *testing.T) {
+	tests := []struct {
+		a, b string
+		c    EditCosts
+		d    int
+	}{
+		{a: "kitten", b: "sitting", c: EditCosts{Insert: 1, Delete: 1, Substitute: 2}, d: 5},
+		{a: "abc", b: "", c: EditCosts{Insert: 1, Delete: 3, Substitute: 1}, d: 9},
+		{a: "", b: "abc", c: EditCosts{Insert: 2, Delete: 1, Substitute: 1}, d: 6},
+		{a: "ab", b: "ba", c: EditCosts{Insert: 1, Delete: 1, Substitute: 5}, d: 2},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.d, EditDistanceWithCosts([]byte(test.a), []byte(test.b), test.c))
+	}
+}
+
+func TestDamerauEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		d    int
+	}{
+		{a: "", b: "", d: 0},
+		{a: "ca", b: "ac", d: 1},
+		{a: "ca", b: "abc", d: 3},
+		{a: "kitten", b: "sitting", d: 3},
+		{a: "abcdef", b: "badcfe", d: 3},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.d, DamerauEditDistance([]byte(test.a), []byte(test.b)))
+	}
+}
+
+func TestDamerauEditDistanceWithCosts(t *testing.T) {
+	c := EditCosts{Insert: 1, Delete: 1, Substitute: 1, Transpose: 3}
+	assertEqual(t, 2, DamerauEditDistanceWithCosts([]byte("ca"), []byte("ac"), c))
+	c.Transpose = 1
+	assertEqual(t, 1, DamerauEditDistanceWithCosts([]byte("ca"), []byte("ac"), c))
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	b, bidx := LongestCommonSubsequence([]byte("ABCBDAB"), []byte("BDCABA"))
+	assertEqual(t, 4, len(b))
+	assertEqual(t, 4, len(bidx))
+
+	e, idx := LongestCommonSubsequence([]int{}, []int{1, 2})
+	assertEqual(t, []int{}, e)
+	assertEqual(t, []Pair[int, int]{}, idx)
+
+	e, idx = LongestCommonSubsequence([]int{1, 2, 3}, []int{0, 1, 3})
+	assertEqual(t, []int{1, 3}, e)
+	assertEqual(t, []Pair[int, int]{{0, 1}, {2, 2}}, idx)
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		a := Map(make([]int, rnd.Intn(40)), func(int) int { return rnd.Intn(5) })
+		b := Map(make([]int, rnd.Intn(40)), func(int) int { return rnd.Intn(5) })
+		e, idx := LongestCommonSubsequence(a, b)
+		assertEqual(t, lcsLen(a, b), len(e))
+		for k, p := range idx {
+			if a[p.First] != e[k] || b[p.Second] != e[k] || (k > 0 && (p.First <= idx[k-1].First || p.Second <= idx[k-1].Second)) {
+				t.Fatalf("Test %s: Received invalid indices %v for %v and %v", t.Name(), idx, a, b)
+			}
+		}
+	}
+}
+
+func TestLongestIncreasingSubsequence(t *testing.T) {
+	tests := []struct {
+		s, e []int
+		idx  []int
+	}{
+		{s: nil, e: []int{}, idx: []int{}},
+		{s: []int{5}, e: []int{5}, idx: []int{0}},
+		{s: []int{3, 3, 3}, e: []int{3}, idx: []int{2}},
+		{s: []int{0, 8, 4, 12, 2, 10, 6, 14, 1, 9, 5, 13, 3, 11, 7, 15}, e: []int{0, 2, 6, 9, 11, 15}, idx: []int{0, 4, 6, 9, 13, 15}},
+	}
+
+	for _, test := range tests {
+		e, idx := LongestIncreasingSubsequence(test.s, lessInt)
+		assertEqual(t, test.e, e)
+		assertEqual(t, test.idx, idx)
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		s := Map(make([]int, rnd.Intn(50)), func(int) int { return rnd.Intn(20) })
+		best := 0
+		dp := make([]int, len(s))
+		for j := range s {
+			dp[j] = 1
+			for k := 0; k < j; k++ {
+				if s[k] < s[j] && dp[k]+1 > dp[j] {
+					dp[j] = dp[k] + 1
+				}
+			}
+			if dp[j] > best {
+				best = dp[j]
+			}
+		}
+		e, _ := LongestIncreasingSubsequence(s, lessInt)
+		assertEqual(t, best, len(e))
+		for k := 1; k < len(e); k++ {
+			if e[k-1] >= e[k] {
+				t.Fatalf("Test %s: Expected increasing subsequence, Received %v", t.Name(), e)
+			}
+		}
+	}
+}
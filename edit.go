@@ -0,0 +1,247 @@
+package slices
+
+import "unsafe"
+
+// Insert returns a newly allocated slice with the value v inserted into the
+// slice s at index i, shifting the following elements back.
+//
+// If i is not in the range [0, len(s)], an error is returned.
+func Insert[E any](s []E, i int, v E) ([]E, error) {
+	return splice(s, i, i, []E{v})
+}
+
+// InsertInPlace returns a slice with the value v inserted into the slice s
+// at index i, shifting the following elements back. The underlying array of
+// slice s is reused if it has sufficient capacity.
+//
+// If i is not in the range [0, len(s)], an error is returned.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func InsertInPlace[E any](s []E, i int, v E) ([]E, error) {
+	return spliceInPlace(s, i, i, []E{v})
+}
+
+// InsertAll returns a newly allocated slice with the values v inserted into
+// the slice s at index i, shifting the following elements back.
+//
+// If i is not in the range [0, len(s)], an error is returned.
+func InsertAll[E any](s []E, i int, v []E) ([]E, error) {
+	return splice(s, i, i, v)
+}
+
+// InsertAllInPlace returns a slice with the values v inserted into the slice
+// s at index i, shifting the following elements back. The underlying array
+// of slice s is reused if it has sufficient capacity. The values v may
+// share the underlying array of slice s.
+//
+// If i is not in the range [0, len(s)], an error is returned.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func InsertAllInPlace[E any](s []E, i int, v []E) ([]E, error) {
+	return spliceInPlace(s, i, i, v)
+}
+
+// DeleteAt returns a newly allocated slice with the element at index i
+// removed from the slice s.
+//
+// If i is not a valid index of the slice s, an error is returned.
+func DeleteAt[E any](s []E, i int) ([]E, error) {
+	return splice(s, i, i+1, nil)
+}
+
+// DeleteAtInPlace returns a slice with the element at index i removed from
+// the slice s, shifting the following elements forward. The capacity of
+// slice s is retained and the vacated elements are zeroed.
+//
+// If i is not a valid index of the slice s, an error is returned.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func DeleteAtInPlace[E any](s []E, i int) ([]E, error) {
+	return spliceInPlace(s, i, i+1, nil)
+}
+
+// DeleteRange returns a newly allocated slice with the elements s[i:j]
+// removed from the slice s.
+//
+// If the range is not valid for the slice s, an error is returned.
+func DeleteRange[E any](s []E, i, j int) ([]E, error) {
+	return splice(s, i, j, nil)
+}
+
+// DeleteRangeInPlace returns a slice with the elements s[i:j] removed from
+// the slice s, shifting the following elements forward. The capacity of
+// slice s is retained and the vacated elements are zeroed.
+//
+// If the range is not valid for the slice s, an error is returned.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func DeleteRangeInPlace[E any](s []E, i, j int) ([]E, error) {
+	return spliceInPlace(s, i, j, nil)
+}
+
+// DeleteFunc returns a newly allocated slice of all elements of the slice s
+// except those for which the function fn returns true.
+func DeleteFunc[E any](s []E, fn func(e E) bool) []E {
+	return Filter(s, func(e E) bool { return !fn(e) })
+}
+
+// DeleteFuncInPlace returns a slice of all elements of the slice s except
+// those for which the function fn returns true. The capacity of slice s is
+// retained and the vacated elements are zeroed.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func DeleteFuncInPlace[E any](s []E, fn func(e E) bool) []E {
+	n := 0
+	for _, e := range s {
+		if !fn(e) {
+			s[n] = e
+			n++
+		}
+	}
+	zeroTail(s, n)
+	return s[:n]
+}
+
+// Splice returns a newly allocated slice with the elements s[i:j] of the
+// slice s replaced by the values v.
+//
+// If the range is not valid for the slice s, an error is returned.
+func Splice[E any](s []E, i, j int, v []E) ([]E, error) {
+	return splice(s, i, j, v)
+}
+
+// SpliceInPlace returns a slice with the elements s[i:j] of the slice s
+// replaced by the values v. The underlying array of slice s is reused if it
+// has sufficient capacity, in which case its capacity is retained and the
+// vacated elements are zeroed. The values v may share the underlying array
+// of slice s.
+//
+// If the range is not valid for the slice s, an error is returned.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func SpliceInPlace[E any](s []E, i, j int, v []E) ([]E, error) {
+	return spliceInPlace(s, i, j, v)
+}
+
+// Move returns a newly allocated slice with the element at index from of
+// the slice s moved to index to, shifting the elements in between.
+//
+// If from or to is not a valid index of the slice s, an error is returned.
+func Move[E any](s []E, from, to int) ([]E, error) {
+	if !validIndex(s, from) || !validIndex(s, to) {
+		return nil, errIndexOutOfRange
+	}
+	return MoveInPlace(clone(s), from, to)
+}
+
+// MoveInPlace moves the element at index from of the slice s to index to,
+// shifting the elements in between.
+//
+// If from or to is not a valid index of the slice s, an error is returned.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func MoveInPlace[E any](s []E, from, to int) ([]E, error) {
+	if !validIndex(s, from) || !validIndex(s, to) {
+		return nil, errIndexOutOfRange
+	}
+	e := s[from]
+	if from < to {
+		copy(s[from:to], s[from+1:to+1])
+	} else {
+		copy(s[to+1:from+1], s[to:from])
+	}
+	s[to] = e
+	return s, nil
+}
+
+// Swap returns a newly allocated slice with the elements at the indices i
+// and j of the slice s swapped.
+//
+// If i or j is not a valid index of the slice s, an error is returned.
+func Swap[E any](s []E, i, j int) ([]E, error) {
+	if !validIndex(s, i) || !validIndex(s, j) {
+		return nil, errIndexOutOfRange
+	}
+	return SwapInPlace(clone(s), i, j)
+}
+
+// SwapInPlace swaps the elements at the indices i and j of the slice s.
+//
+// If i or j is not a valid index of the slice s, an error is returned.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func SwapInPlace[E any](s []E, i, j int) ([]E, error) {
+	if !validIndex(s, i) || !validIndex(s, j) {
+		return nil, errIndexOutOfRange
+	}
+	s[i], s[j] = s[j], s[i]
+	return s, nil
+}
+
+// validIndex reports whether i is a valid index of the slice s.
+func validIndex[E any](s []E, i int) bool {
+	return i >= 0 && i < len(s)
+}
+
+// splice returns a newly allocated slice with s[i:j] replaced by v.
+func splice[E any](s []E, i, j int, v []E) ([]E, error) {
+	if i < 0 || i > j || j > len(s) {
+		return nil, errIndexOutOfRange
+	}
+	r := make([]E, len(s)-(j-i)+len(v))
+	n := copy(r, s[:i])
+	n += copy(r[n:], v)
+	copy(r[n:], s[j:])
+	return r, nil
+}
+
+// spliceInPlace replaces s[i:j] by v, reusing the underlying array of slice s
+// if it has sufficient capacity. The capacity of slice s is retained, so that
+// subsequent insertions may reuse it, and the vacated elements are zeroed.
+func spliceInPlace[E any](s []E, i, j int, v []E) ([]E, error) {
+	if i < 0 || i > j || j > len(s) {
+		return nil, errIndexOutOfRange
+	}
+	n := len(s) - (j - i) + len(v)
+	if n > cap(s) {
+		return splice(s, i, j, v)
+	}
+	if overlaps(s[:n], v) {
+		v = clone(v)
+	}
+	r := s[:n]
+	copy(r[i+len(v):], s[j:])
+	copy(r[i:], v)
+	zeroTail(s, n)
+	return r, nil
+}
+
+// zeroTail sets the elements of the slice s from index n onwards to their
+// zero value, so that they can be garbage collected.
+func zeroTail[E any](s []E, n int) {
+	var zero E
+	for i := n; i < len(s); i++ {
+		s[i] = zero
+	}
+}
+
+// overlaps reports whether the memory ranges of the slices a and b overlap.
+func overlaps[E any](a, b []E) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	size := unsafe.Sizeof(a[0])
+	if size == 0 {
+		return false
+	}
+	return uintptr(unsafe.Pointer(&a[0])) <= uintptr(unsafe.Pointer(&b[len(b)-1]))+(size-1) &&
+		uintptr(unsafe.Pointer(&b[0])) <= uintptr(unsafe.Pointer(&a[len(a)-1]))+(size-1)
+}
@@ -0,0 +1,276 @@
+package slices
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestInsert(t *testing.T) {
+	tests := []struct {
+		s    []int
+		i, v int
+		e    []int
+		err  error
+	}{
+		{s: nil, i: 0, v: 1, e: []int{1}, err: nil},
+		{s: []int{1, 2}, i: 0, v: 0, e: []int{0, 1, 2}, err: nil},
+		{s: []int{1, 2}, i: 1, v: 0, e: []int{1, 0, 2}, err: nil},
+		{s: []int{1, 2}, i: 2, v: 0, e: []int{1, 2, 0}, err: nil},
+		{s: []int{1, 2}, i: 3, v: 0, e: nil, err: errIndexOutOfRange},
+		{s: []int{1, 2}, i: -1, v: 0, e: nil, err: errIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		r, err := Insert(test.s, test.i, test.v)
+		assertEqual(t, test.e, r)
+		assertEqual(t, test.err, err)
+	}
+}
+
+func TestInsertInPlace(t *testing.T) {
+	s := make([]int, 3, 4)
+	copy(s, []int{1, 2, 3})
+	r, err := InsertInPlace(s, 1, 0)
+	assertNil(t, err)
+	assertEqual(t, []int{1, 0, 2, 3}, r)
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&r[0]))
+
+	r, err = InsertInPlace(r, 4, 4)
+	assertNil(t, err)
+	assertEqual(t, []int{1, 0, 2, 3, 4}, r)
+
+	_, err = InsertInPlace(r, 6, 0)
+	assertEqual(t, errIndexOutOfRange, err)
+}
+
+func TestInsertAll(t *testing.T) {
+	tests := []struct {
+		s   []int
+		i   int
+		v   []int
+		e   []int
+		err error
+	}{
+		{s: nil, i: 0, v: nil, e: []int{}, err: nil},
+		{s: []int{1, 2}, i: 1, v: []int{3, 4}, e: []int{1, 3, 4, 2}, err: nil},
+		{s: []int{1, 2}, i: 3, v: []int{3, 4}, e: nil, err: errIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		r, err := InsertAll(test.s, test.i, test.v)
+		assertEqual(t, test.e, r)
+		assertEqual(t, test.err, err)
+	}
+}
+
+func TestInsertAllInPlace(t *testing.T) {
+	s := make([]int, 4, 8)
+	copy(s, []int{1, 2, 3, 4})
+	r, err := InsertAllInPlace(s, 1, s[2:])
+	assertNil(t, err)
+	assertEqual(t, []int{1, 3, 4, 2, 3, 4}, r)
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&r[0]))
+
+	r, err = InsertAllInPlace(r, 0, []int{7, 8, 9})
+	assertNil(t, err)
+	assertEqual(t, []int{7, 8, 9, 1, 3, 4, 2, 3, 4}, r)
+}
+
+func TestDeleteAt(t *testing.T) {
+	tests := []struct {
+		s   []int
+		i   int
+		e   []int
+		err error
+	}{
+		{s: nil, i: 0, e: nil, err: errIndexOutOfRange},
+		{s: []int{1, 2, 3}, i: 0, e: []int{2, 3}, err: nil},
+		{s: []int{1, 2, 3}, i: 2, e: []int{1, 2}, err: nil},
+		{s: []int{1, 2, 3}, i: 3, e: nil, err: errIndexOutOfRange},
+		{s: []int{1, 2, 3}, i: -1, e: nil, err: errIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		r, err := DeleteAt(test.s, test.i)
+		assertEqual(t, test.e, r)
+		assertEqual(t, test.err, err)
+	}
+}
+
+func TestDeleteAtInPlace(t *testing.T) {
+	s := []int{1, 2, 3}
+	r, err := DeleteAtInPlace(s, 1)
+	assertNil(t, err)
+	assertEqual(t, []int{1, 3}, r)
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&r[0]))
+
+	_, err = DeleteAtInPlace(r, 2)
+	assertEqual(t, errIndexOutOfRange, err)
+
+	a, b, c := 1, 2, 3
+	p := []*int{&a, &b, &c}
+	q, err := DeleteAtInPlace(p, 0)
+	assertNil(t, err)
+	assertEqual(t, []*int{&b, &c}, q)
+	assertEqual(t, 3, cap(q))
+	assertNil(t, p[2])
+}
+
+func TestDeleteRange(t *testing.T) {
+	tests := []struct {
+		s    []int
+		i, j int
+		e    []int
+		err  error
+	}{
+		{s: nil, i: 0, j: 0, e: []int{}, err: nil},
+		{s: []int{1, 2, 3, 4}, i: 1, j: 3, e: []int{1, 4}, err: nil},
+		{s: []int{1, 2, 3, 4}, i: 0, j: 4, e: []int{}, err: nil},
+		{s: []int{1, 2, 3, 4}, i: 3, j: 1, e: nil, err: errIndexOutOfRange},
+		{s: []int{1, 2, 3, 4}, i: 1, j: 5, e: nil, err: errIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		r, err := DeleteRange(test.s, test.i, test.j)
+		assertEqual(t, test.e, r)
+		assertEqual(t, test.err, err)
+	}
+}
+
+func TestDeleteRangeInPlace(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+	r, err := DeleteRangeInPlace(s, 0, 2)
+	assertNil(t, err)
+	assertEqual(t, []int{3, 4}, r)
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&r[0]))
+}
+
+func TestDeleteFunc(t *testing.T) {
+	tests := []struct {
+		s, e []int
+	}{
+		{s: nil, e: []int{}},
+		{s: []int{1, 2, 3, 4, 5}, e: []int{1, 3, 5}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, DeleteFunc(test.s, func(i int) bool { return i%2 == 0 }))
+	}
+}
+
+func TestDeleteFuncInPlace(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	r := DeleteFuncInPlace(s, func(i int) bool { return i%2 == 0 })
+	assertEqual(t, []int{1, 3, 5}, r)
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&r[0]))
+	assertEqual(t, 5, cap(r))
+	assertEqual(t, []int{1, 3, 5, 0, 0}, s)
+}
+
+func TestSplice(t *testing.T) {
+	tests := []struct {
+		s    []int
+		i, j int
+		v    []int
+		e    []int
+		err  error
+	}{
+		{s: nil, i: 0, j: 0, v: []int{1}, e: []int{1}, err: nil},
+		{s: []int{1, 2, 3, 4}, i: 1, j: 3, v: []int{5}, e: []int{1, 5, 4}, err: nil},
+		{s: []int{1, 2, 3, 4}, i: 1, j: 2, v: []int{5, 6, 7}, e: []int{1, 5, 6, 7, 3, 4}, err: nil},
+		{s: []int{1, 2, 3, 4}, i: 2, j: 5, v: nil, e: nil, err: errIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		r, err := Splice(test.s, test.i, test.j, test.v)
+		assertEqual(t, test.e, r)
+		assertEqual(t, test.err, err)
+	}
+}
+
+func TestSpliceInPlace(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	r, err := SpliceInPlace(s, 1, 3, s[3:])
+	assertNil(t, err)
+	assertEqual(t, []int{1, 4, 5, 4, 5}, r)
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&r[0]))
+
+	r, err = SpliceInPlace(r, 0, 1, []int{6, 7})
+	assertNil(t, err)
+	assertEqual(t, []int{6, 7, 4, 5, 4, 5}, r)
+
+	s = make([]int, 4, 8)
+	copy(s, []int{1, 2, 3, 4})
+	r, err = SpliceInPlace(s, 0, 1, s[1:3])
+	assertNil(t, err)
+	assertEqual(t, []int{2, 3, 2, 3, 4}, r)
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&r[0]))
+
+	v := []int{1, 2}
+	allocs := testing.AllocsPerRun(10, func() { _, _ = SpliceInPlace(s[:4], 1, 3, v) })
+	assertEqual(t, 0.0, allocs)
+
+	_, err = SpliceInPlace(r, -1, 1, nil)
+	assertEqual(t, errIndexOutOfRange, err)
+}
+
+func TestMove(t *testing.T) {
+	tests := []struct {
+		s        []int
+		from, to int
+		e        []int
+		err      error
+	}{
+		{s: []int{1, 2, 3, 4}, from: 0, to: 2, e: []int{2, 3, 1, 4}, err: nil},
+		{s: []int{1, 2, 3, 4}, from: 3, to: 1, e: []int{1, 4, 2, 3}, err: nil},
+		{s: []int{1, 2, 3, 4}, from: 2, to: 2, e: []int{1, 2, 3, 4}, err: nil},
+		{s: []int{1, 2, 3, 4}, from: 4, to: 0, e: nil, err: errIndexOutOfRange},
+		{s: []int{1, 2, 3, 4}, from: 0, to: 4, e: nil, err: errIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		r, err := Move(test.s, test.from, test.to)
+		assertEqual(t, test.e, r)
+		assertEqual(t, test.err, err)
+		assertEqual(t, []int{1, 2, 3, 4}, test.s)
+	}
+}
+
+func TestMoveInPlace(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+	r, err := MoveInPlace(s, 0, 3)
+	assertNil(t, err)
+	assertEqual(t, []int{2, 3, 4, 1}, r)
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&r[0]))
+}
+
+func TestSwap(t *testing.T) {
+	tests := []struct {
+		s    []int
+		i, j int
+		e    []int
+		err  error
+	}{
+		{s: []int{1, 2, 3}, i: 0, j: 2, e: []int{3, 2, 1}, err: nil},
+		{s: []int{1, 2, 3}, i: 1, j: 1, e: []int{1, 2, 3}, err: nil},
+		{s: []int{1, 2, 3}, i: 0, j: 3, e: nil, err: errIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		r, err := Swap(test.s, test.i, test.j)
+		assertEqual(t, test.e, r)
+		assertEqual(t, test.err, err)
+		assertEqual(t, []int{1, 2, 3}, test.s)
+	}
+}
+
+func TestSwapInPlace(t *testing.T) {
+	s := []int{1, 2, 3}
+	r, err := SwapInPlace(s, 0, 1)
+	assertNil(t, err)
+	assertEqual(t, []int{2, 1, 3}, r)
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&r[0]))
+
+	_, err = SwapInPlace(s, -1, 1)
+	assertEqual(t, errIndexOutOfRange, err)
+}
@@ -0,0 +1,221 @@
+package slices
+
+// InnerJoin returns pairs of all elements of the slices left and right
+// whose keys returned by the functions lk and rk are equal.
+//
+// It builds a hash index on the right slice and runs in O(n+m) time plus
+// the size of the result. The pairs are ordered by the left elements and
+// then by the right elements.
+func InnerJoin[L, R any, K comparable](left []L, right []R, lk func(l L) K, rk func(r R) K) []Pair[L, R] {
+	return InnerJoinWith(left, right, lk, rk, func(l L, r R) Pair[L, R] { return Pair[L, R]{l, r} })
+}
+
+// InnerJoinWith returns the results of applying the function fn to all
+// elements of the slices left and right whose keys returned by the functions
+// lk and rk are equal.
+//
+// It builds a hash index on the right slice and runs in O(n+m) time plus
+// the size of the result. The results are ordered by the left elements and
+// then by the right elements.
+func InnerJoinWith[L, R any, K comparable, T any](left []L, right []R, lk func(l L) K, rk func(r R) K, fn func(l L, r R) T) []T {
+	m := indexBy(right, rk)
+	r := make([]T, 0, len(left))
+	for _, l := range left {
+		for _, j := range m[lk(l)] {
+			r = append(r, fn(l, right[j]))
+		}
+	}
+	return r
+}
+
+// LeftJoin returns pairs of all elements of the slices left and right whose
+// keys returned by the functions lk and rk are equal. Elements of the left
+// slice without a match are paired with nil. The second value of each pair
+// points into the right slice.
+//
+// It builds a hash index on the right slice and runs in O(n+m) time plus
+// the size of the result. The pairs are ordered by the left elements and
+// then by the right elements.
+func LeftJoin[L, R any, K comparable](left []L, right []R, lk func(l L) K, rk func(r R) K) []Pair[L, *R] {
+	return LeftJoinWith(left, right, lk, rk, func(l L, r *R) Pair[L, *R] { return Pair[L, *R]{l, r} })
+}
+
+// LeftJoinWith returns the results of applying the function fn to all
+// elements of the slices left and right whose keys returned by the functions
+// lk and rk are equal. For elements of the left slice without a match, fn is
+// called with nil. The second argument of fn points into the right slice.
+//
+// It builds a hash index on the right slice and runs in O(n+m) time plus
+// the size of the result. The results are ordered by the left elements and
+// then by the right elements.
+func LeftJoinWith[L, R any, K comparable, T any](left []L, right []R, lk func(l L) K, rk func(r R) K, fn func(l L, r *R) T) []T {
+	m := indexBy(right, rk)
+	r := make([]T, 0, len(left))
+	for _, l := range left {
+		js := m[lk(l)]
+		if len(js) == 0 {
+			r = append(r, fn(l, nil))
+		}
+		for _, j := range js {
+			r = append(r, fn(l, &right[j]))
+		}
+	}
+	return r
+}
+
+// RightJoin returns pairs of all elements of the slices left and right
+// whose keys returned by the functions lk and rk are equal. Elements of the
+// right slice without a match are paired with nil. The first value of each
+// pair points into the left slice.
+//
+// It builds a hash index on the left slice and runs in O(n+m) time plus
+// the size of the result. The pairs are ordered by the right elements and
+// then by the left elements.
+func RightJoin[L, R any, K comparable](left []L, right []R, lk func(l L) K, rk func(r R) K) []Pair[*L, R] {
+	return RightJoinWith(left, right, lk, rk, func(l *L, r R) Pair[*L, R] { return Pair[*L, R]{l, r} })
+}
+
+// RightJoinWith returns the results of applying the function fn to all
+// elements of the slices left and right whose keys returned by the functions
+// lk and rk are equal. For elements of the right slice without a match, fn is
+// called with nil. The first argument of fn points into the left slice.
+//
+// It builds a hash index on the left slice and runs in O(n+m) time plus
+// the size of the result. The results are ordered by the right elements and
+// then by the left elements.
+func RightJoinWith[L, R any, K comparable, T any](left []L, right []R, lk func(l L) K, rk func(r R) K, fn func(l *L, r R) T) []T {
+	return LeftJoinWith(right, left, rk, lk, func(r R, l *L) T { return fn(l, r) })
+}
+
+// FullOuterJoin returns pairs of all elements of the slices left and right
+// whose keys returned by the functions lk and rk are equal. Elements of
+// either slice without a match are paired with nil. The values of each pair
+// point into the left and right slice respectively.
+//
+// It builds a hash index on the right slice and runs in O(n+m) time plus
+// the size of the result. The pairs are ordered like LeftJoin, followed by
+// the unmatched elements of the right slice in their original order.
+func FullOuterJoin[L, R any, K comparable](left []L, right []R, lk func(l L) K, rk func(r R) K) []Pair[*L, *R] {
+	return FullOuterJoinWith(left, right, lk, rk, func(l *L, r *R) Pair[*L, *R] { return Pair[*L, *R]{l, r} })
+}
+
+// FullOuterJoinWith returns the results of applying the function fn to all
+// elements of the slices left and right whose keys returned by the functions
+// lk and rk are equal. For elements of either slice without a match, fn is
+// called with nil for the missing value. The arguments of fn point into the
+// left and right slice respectively.
+//
+// It builds a hash index on the right slice and runs in O(n+m) time plus
+// the size of the result. The results are ordered like LeftJoinWith, followed
+// by the unmatched elements of the right slice in their original order.
+func FullOuterJoinWith[L, R any, K comparable, T any](left []L, right []R, lk func(l L) K, rk func(r R) K, fn func(l *L, r *R) T) []T {
+	m := indexBy(right, rk)
+	matched := make([]bool, len(right))
+	r := make([]T, 0, len(left))
+	for i := range left {
+		js := m[lk(left[i])]
+		if len(js) == 0 {
+			r = append(r, fn(&left[i], nil))
+		}
+		for _, j := range js {
+			matched[j] = true
+			r = append(r, fn(&left[i], &right[j]))
+		}
+	}
+	for j := range right {
+		if !matched[j] {
+			r = append(r, fn(nil, &right[j]))
+		}
+	}
+	return r
+}
+
+// SemiJoin returns a newly allocated slice of all elements of the slice left
+// whose key returned by the function lk equals the key of at least one
+// element of the slice right returned by the function rk.
+func SemiJoin[L, R any, K comparable](left []L, right []R, lk func(l L) K, rk func(r R) K) []L {
+	m := indexBy(right, rk)
+	return Filter(left, func(l L) bool { return len(m[lk(l)]) > 0 })
+}
+
+// AntiJoin returns a newly allocated slice of all elements of the slice left
+// whose key returned by the function lk equals the key of no element of the
+// slice right returned by the function rk.
+func AntiJoin[L, R any, K comparable](left []L, right []R, lk func(l L) K, rk func(r R) K) []L {
+	m := indexBy(right, rk)
+	return Filter(left, func(l L) bool { return len(m[lk(l)]) == 0 })
+}
+
+// MergeJoin returns pairs of all elements of the slices left and right whose
+// keys returned by the functions lk and rk are equal, like InnerJoin.
+//
+// Both slices must be sorted in ascending order of their keys. It uses a
+// sort-merge join without building a hash index, thus it runs in O(n+m)
+// time plus the size of the result and needs no extra space.
+func MergeJoin[L, R any, K ordered](left []L, right []R, lk func(l L) K, rk func(r R) K) []Pair[L, R] {
+	return MergeJoinWith(left, right, lk, rk, func(l L, r R) Pair[L, R] { return Pair[L, R]{l, r} })
+}
+
+// MergeJoinWith returns the results of applying the function fn to all
+// elements of the slices left and right whose keys returned by the functions
+// lk and rk are equal, like InnerJoinWith.
+//
+// Both slices must be sorted in ascending order of their keys. It uses a
+// sort-merge join without building a hash index, thus it runs in O(n+m)
+// time plus the size of the result and needs no extra space.
+func MergeJoinWith[L, R any, K ordered, T any](left []L, right []R, lk func(l L) K, rk func(r R) K, fn func(l L, r R) T) []T {
+	r := make([]T, 0)
+	i, j := 0, 0
+	var k1, k2 K
+	if len(left) > 0 {
+		k1 = lk(left[0])
+	}
+	if len(right) > 0 {
+		k2 = rk(right[0])
+	}
+	for i < len(left) && j < len(right) {
+		switch {
+		case k1 < k2:
+			if i++; i < len(left) {
+				k1 = lk(left[i])
+			}
+		case k1 > k2:
+			if j++; j < len(right) {
+				k2 = rk(right[j])
+			}
+		default:
+			// Find the end of the run of equal keys in the right slice and
+			// join it with every left element of the same key. The keys of
+			// both slices are advanced past the run, such that each key
+			// function is called once per element.
+			k := k1
+			e := j + 1
+			for ; e < len(right); e++ {
+				if k2 = rk(right[e]); k2 != k {
+					break
+				}
+			}
+			for i < len(left) && k1 == k {
+				for _, rv := range right[j:e] {
+					r = append(r, fn(left[i], rv))
+				}
+				if i++; i < len(left) {
+					k1 = lk(left[i])
+				}
+			}
+			j = e
+		}
+	}
+	return r
+}
+
+// indexBy returns a map from the keys returned by the function fn to the
+// indices of the elements of the slice s having that key, in ascending order.
+func indexBy[E any, K comparable](s []E, fn func(e E) K) map[K][]int {
+	m := make(map[K][]int, len(s))
+	for i, e := range s {
+		k := fn(e)
+		m[k] = append(m[k], i)
+	}
+	return m
+}
@@ -0,0 +1,135 @@
+package slices
+
+import (
+	"sort"
+	"strconv"
+	"testing"
+)
+
+type joinCustomer struct {
+	id   int
+	name string
+}
+
+type joinOrder struct {
+	id, customer int
+}
+
+var (
+	joinCustomers = []joinCustomer{{1, "Grace"}, {2, "Jacob"}, {3, "Johann"}}
+	joinOrders    = []joinOrder{{10, 2}, {11, 1}, {12, 2}, {13, 4}}
+)
+
+func customerID(c joinCustomer) int { return c.id }
+
+func orderCustomer(o joinOrder) int { return o.customer }
+
+func TestInnerJoin(t *testing.T) {
+	assertEqual(t, []Pair[joinCustomer, joinOrder]{
+		{joinCustomers[0], joinOrders[1]},
+		{joinCustomers[1], joinOrders[0]},
+		{joinCustomers[1], joinOrders[2]},
+	}, InnerJoin(joinCustomers, joinOrders, customerID, orderCustomer))
+
+	assertEqual(t, []Pair[joinCustomer, joinOrder]{}, InnerJoin(joinCustomers, nil, customerID, orderCustomer))
+}
+
+func TestInnerJoinWith(t *testing.T) {
+	assertEqual(t, []string{"Grace:11", "Jacob:10", "Jacob:12"}, InnerJoinWith(joinCustomers, joinOrders, customerID, orderCustomer, func(c joinCustomer, o joinOrder) string {
+		return c.name + ":" + strconv.Itoa(o.id)
+	}))
+}
+
+func TestLeftJoin(t *testing.T) {
+	assertEqual(t, []Pair[joinCustomer, *joinOrder]{
+		{joinCustomers[0], &joinOrders[1]},
+		{joinCustomers[1], &joinOrders[0]},
+		{joinCustomers[1], &joinOrders[2]},
+		{joinCustomers[2], nil},
+	}, LeftJoin(joinCustomers, joinOrders, customerID, orderCustomer))
+
+	p := LeftJoin(joinCustomers, joinOrders, customerID, orderCustomer)
+	assertEqual(t, &joinOrders[1], p[0].Second)
+}
+
+func TestLeftJoinWith(t *testing.T) {
+	assertEqual(t, []int{1, 2, 2, 0}, LeftJoinWith(joinCustomers, joinOrders, customerID, orderCustomer, func(c joinCustomer, o *joinOrder) int {
+		if o == nil {
+			return 0
+		}
+		return o.customer
+	}))
+}
+
+func TestRightJoin(t *testing.T) {
+	assertEqual(t, []Pair[*joinCustomer, joinOrder]{
+		{&joinCustomers[1], joinOrders[0]},
+		{&joinCustomers[0], joinOrders[1]},
+		{&joinCustomers[1], joinOrders[2]},
+		{nil, joinOrders[3]},
+	}, RightJoin(joinCustomers, joinOrders, customerID, orderCustomer))
+}
+
+func TestRightJoinWith(t *testing.T) {
+	assertEqual(t, []string{"Jacob", "Grace", "Jacob", ""}, RightJoinWith(joinCustomers, joinOrders, customerID, orderCustomer, func(c *joinCustomer, o joinOrder) string {
+		if c == nil {
+			return ""
+		}
+		return c.name
+	}))
+}
+
+func TestFullOuterJoin(t *testing.T) {
+	assertEqual(t, []Pair[*joinCustomer, *joinOrder]{
+		{&joinCustomers[0], &joinOrders[1]},
+		{&joinCustomers[1], &joinOrders[0]},
+		{&joinCustomers[1], &joinOrders[2]},
+		{&joinCustomers[2], nil},
+		{nil, &joinOrders[3]},
+	}, FullOuterJoin(joinCustomers, joinOrders, customerID, orderCustomer))
+}
+
+func TestFullOuterJoinWith(t *testing.T) {
+	assertEqual(t, []Pair[int, int]{{1, 11}, {2, 10}, {2, 12}, {3, 0}, {0, 13}}, FullOuterJoinWith(joinCustomers, joinOrders, customerID, orderCustomer, func(c *joinCustomer, o *joinOrder) Pair[int, int] {
+		var p Pair[int, int]
+		if c != nil {
+			p.First = c.id
+		}
+		if o != nil {
+			p.Second = o.id
+		}
+		return p
+	}))
+}
+
+func TestSemiJoin(t *testing.T) {
+	assertEqual(t, joinCustomers[:2], SemiJoin(joinCustomers, joinOrders, customerID, orderCustomer))
+	assertEqual(t, []joinOrder{joinOrders[0], joinOrders[1], joinOrders[2]}, SemiJoin(joinOrders, joinCustomers, orderCustomer, customerID))
+}
+
+func TestAntiJoin(t *testing.T) {
+	assertEqual(t, joinCustomers[2:], AntiJoin(joinCustomers, joinOrders, customerID, orderCustomer))
+	assertEqual(t, joinOrders[3:], AntiJoin(joinOrders, joinCustomers, orderCustomer, customerID))
+}
+
+func TestMergeJoin(t *testing.T) {
+	orders := clone(joinOrders)
+	sort.Slice(orders, func(i, j int) bool { return orders[i].customer < orders[j].customer })
+	assertEqual(t, InnerJoin(joinCustomers, orders, customerID, orderCustomer), MergeJoin(joinCustomers, orders, customerID, orderCustomer))
+
+	left := []int{1, 1, 2, 3, 5}
+	right := []int{1, 1, 3, 4, 5, 5}
+	id := func(i int) int { return i }
+	assertEqual(t, InnerJoin(left, right, id, id), MergeJoin(left, right, id, id))
+	assertEqual(t, []Pair[int, int]{}, MergeJoin(left, nil, id, id))
+
+	calls := 0
+	counted := func(i int) int { calls++; return i }
+	assertEqual(t, InnerJoin(left, right, id, id), MergeJoin(left, right, counted, counted))
+	assertEqual(t, true, calls <= len(left)+len(right))
+}
+
+func TestMergeJoinWith(t *testing.T) {
+	id := func(i int) int { return i }
+	assertEqual(t, []int{2, 2, 6}, MergeJoinWith([]int{1, 3}, []int{1, 1, 3}, id, id, func(l, r int) int { return l + r }))
+}
@@ -0,0 +1,59 @@
+package slices
+
+import "sort"
+
+// MapReduce runs a local MapReduce job over the elements of the slice s.
+//
+// The function mapper is applied to each element and emits key/value pairs.
+// If combiner is not nil, it is applied to the values of each key within
+// each shard of the input to reduce them to a single value before the
+// shuffle. The values of all shards are then grouped by key and the function
+// reducer is applied to the values of each key. The values passed to the
+// combiner and reducer retain the order in which they were emitted.
+//
+// The slice is split into contiguous shards which are mapped concurrently by
+// the given number of workers, which also reduce the keys concurrently. The
+// functions are called concurrently and must be safe for concurrent use.
+// If workers is not positive, runtime.GOMAXPROCS(0) workers are used.
+//
+// The results are returned as pairs of each key and its reduced value,
+// sorted by key for reproducible results.
+func MapReduce[E any, K ordered, V, R any](s []E, mapper func(e E) []Pair[K, V], combiner func(k K, vs []V) V, reducer func(k K, vs []V) R, workers int) []Pair[K, R] {
+	shards := shard(s, workers)
+	groups := make([]map[K][]V, len(shards))
+	parallel(len(shards), func(i int) {
+		pairs := FlatMap(shards[i], mapper)
+		m := make(map[K][]V)
+		for k, g := range GroupBy(pairs, func(p Pair[K, V]) K { return p.First }) {
+			vs := Map(g, func(p Pair[K, V]) V { return p.Second })
+			if combiner != nil {
+				vs = []V{combiner(k, vs)}
+			}
+			m[k] = vs
+		}
+		groups[i] = m
+	})
+
+	// Shuffle the values of all shards by key, retaining their order.
+	m := make(map[K][]V)
+	for _, g := range groups {
+		for k, vs := range g {
+			m[k] = append(m[k], vs...)
+		}
+	}
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	r := make([]Pair[K, R], len(keys))
+	parts := shard(keys, workers)
+	offsets := ScanLeft(parts, 0, func(acc int, p []K) int { return acc + len(p) })
+	parallel(len(parts), func(i int) {
+		for j, k := range parts[i] {
+			r[offsets[i]+j] = Pair[K, R]{k, reducer(k, m[k])}
+		}
+	})
+	return r
+}
@@ -0,0 +1,46 @@
+package slices
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMapReduce(t *testing.T) {
+	lines := []string{
+		"the quick brown fox",
+		"jumps over the lazy dog",
+		"the dog barks",
+		"",
+	}
+	mapper := func(line string) []Pair[string, int] {
+		return Map(strings.Fields(line), func(w string) Pair[string, int] { return Pair[string, int]{w, 1} })
+	}
+	sum := func(k string, vs []int) int { return SumOf(vs, func(v int) int { return v }) }
+
+	e := []Pair[string, int]{
+		{"barks", 1}, {"brown", 1}, {"dog", 2}, {"fox", 1}, {"jumps", 1},
+		{"lazy", 1}, {"over", 1}, {"quick", 1}, {"the", 3},
+	}
+	for _, workers := range []int{0, 1, 2, 8} {
+		assertEqual(t, e, MapReduce(lines, mapper, nil, sum, workers))
+		assertEqual(t, e, MapReduce(lines, mapper, sum, sum, workers))
+	}
+
+	assertEqual(t, []Pair[string, int]{}, MapReduce(nil, mapper, nil, sum, 4))
+}
+
+func TestMapReduceOrder(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	mapper := func(i int) []Pair[int, int] { return []Pair[int, int]{{i % 2, i}} }
+	var combined int32
+	combiner := func(k int, vs []int) int {
+		atomic.AddInt32(&combined, 1)
+		return Fold(vs, 0, func(acc, v int) int { return acc*10 + v })
+	}
+	values := func(k int, vs []int) []int { return vs }
+
+	assertEqual(t, []Pair[int, []int]{{0, []int{2, 4, 6, 8}}, {1, []int{1, 3, 5, 7, 9}}}, MapReduce(s, mapper, nil, values, 3))
+	assertEqual(t, []Pair[int, []int]{{0, []int{2, 46, 8}}, {1, []int{13, 5, 79}}}, MapReduce(s, mapper, combiner, values, 3))
+	assertEqual(t, int32(6), combined)
+}
@@ -0,0 +1,71 @@
+package slices
+
+import "reflect"
+
+// FlatMap applies the function fn to each element of the slice s and returns
+// a single slice of all elements yielded by the successive calls of fn.
+//
+// The result is pre-sized to hold at least one element per element of s,
+// thus mappings yielding single elements do not reallocate.
+func FlatMap[E1, E2 any](s []E1, fn func(e E1) []E2) []E2 {
+	r := make([]E2, 0, len(s))
+	for _, e := range s {
+		r = append(r, fn(e)...)
+	}
+	return r
+}
+
+// FlattenDeep returns a single slice of all elements of type E contained in
+// the arbitrarily nested slices or arrays s, e.g. a [][][]E or a []any holding
+// values of type E and further slices.
+//
+// Slices and arrays are descended into unless their type is E itself.
+// If s contains a value which is neither a slice, an array nor of type E,
+// FlattenDeep will panic.
+func FlattenDeep[E any](s any) []E {
+	r := make([]E, 0)
+	if s == nil {
+		return r
+	}
+	return flattenDeep(reflect.ValueOf(s), reflect.TypeOf((*E)(nil)).Elem(), r)
+}
+
+// flattenDeep appends all values of type t contained in the value v to r.
+func flattenDeep[E any](v reflect.Value, t reflect.Type, r []E) []E {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		if t.Kind() != reflect.Interface {
+			panic(errInvalidType)
+		}
+		var zeroValue E
+		return append(r, zeroValue)
+	}
+	if (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type() != t {
+		for i := 0; i < v.Len(); i++ {
+			r = flattenDeep(v.Index(i), t, r)
+		}
+		return r
+	}
+	e, ok := v.Interface().(E)
+	if !ok {
+		panic(errInvalidType)
+	}
+	return append(r, e)
+}
+
+// MapNested applies the function fn to each element of the nested slices in
+// the slice s. It returns newly allocated nested slices with the same
+// structure as s.
+func MapNested[E1, E2 any](s [][]E1, fn func(e E1) E2) [][]E2 {
+	return Map(s, func(e []E1) []E2 { return Map(e, fn) })
+}
+
+// FilterNested executes the function fn to each element of the nested slices
+// in the slice s. It returns newly allocated nested slices with the same
+// structure as s, containing only the elements for which the function fn
+// returns true. Nested slices are retained even if they become empty.
+func FilterNested[E any](s [][]E, fn func(e E) bool) [][]E {
+	return Map(s, func(e []E) []E { return Filter(e, fn) })
+}
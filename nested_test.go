@@ -0,0 +1,66 @@
+package slices
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestFlatMap(t *testing.T) {
+	tests := []struct {
+		s []int
+		e []string
+	}{
+		{s: nil, e: []string{}},
+		{s: []int{0, 0}, e: []string{}},
+		{s: []int{1, 2, 3}, e: []string{"1", "2", "2", "3", "3", "3"}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, FlatMap(test.s, func(i int) []string {
+			r := make([]string, i)
+			for j := range r {
+				r[j] = strconv.Itoa(i)
+			}
+			return r
+		}))
+	}
+}
+
+func TestFlattenDeep(t *testing.T) {
+	assertEqual(t, []int{}, FlattenDeep[int](nil))
+	assertEqual(t, []int{}, FlattenDeep[int]([][][]int{}))
+	assertEqual(t, []int{1, 2, 3, 4, 5}, FlattenDeep[int]([][][]int{{{1, 2}, {3}}, {}, {{4, 5}}}))
+	assertEqual(t, []int{1, 2, 3, 4}, FlattenDeep[int]([]any{1, []int{2}, []any{[2]int{3, 4}}}))
+	assertEqual(t, []any{1, "a", nil}, FlattenDeep[any]([]any{1, []any{"a", []any{nil}}}))
+	assertEqual(t, [][]int{{1}, {2, 3}}, FlattenDeep[[]int]([][][]int{{{1}}, {{2, 3}}}))
+
+	assertPanic(t, errInvalidType, func() { FlattenDeep[int]([]any{1, "a"}) })
+	assertPanic(t, errInvalidType, func() { FlattenDeep[int]([]any{1, nil}) })
+}
+
+func TestMapNested(t *testing.T) {
+	tests := []struct {
+		s [][]int
+		e [][]string
+	}{
+		{s: nil, e: [][]string{}},
+		{s: [][]int{{1, 2}, {}, {3}}, e: [][]string{{"1", "2"}, {}, {"3"}}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, MapNested(test.s, strconv.Itoa))
+	}
+}
+
+func TestFilterNested(t *testing.T) {
+	tests := []struct {
+		s, e [][]int
+	}{
+		{s: nil, e: [][]int{}},
+		{s: [][]int{{1, 2, 3}, {1}, {4, 5}}, e: [][]int{{3}, {}, {4, 5}}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, FilterNested(test.s, func(i int) bool { return i > 2 }))
+	}
+}
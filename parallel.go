@@ -0,0 +1,210 @@
+package slices
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+// ParallelReduce computes the reduction of the function fn across the
+// elements of the slice s like Reduce, splitting the slice into contiguous
+// shards which are reduced concurrently by the given number of workers.
+// The partial results are combined with fn in the order of the shards.
+//
+// The function fn must be associative, but need not be commutative. It is
+// called concurrently and must be safe for concurrent use. If workers is not
+// positive, runtime.GOMAXPROCS(0) workers are used.
+//
+// If the slice is empty, ParallelReduce will panic; if it has only one
+// element, it returns that element.
+func ParallelReduce[E any](s []E, fn func(acc, e E) E, workers int) E {
+	if len(s) == 0 {
+		panic(errEmptySlice)
+	}
+	shards := shard(s, workers)
+	r := make([]E, len(shards))
+	parallel(len(shards), func(i int) {
+		r[i] = Reduce(shards[i], fn)
+	})
+	return Reduce(r, fn)
+}
+
+// ParallelGroupBy groups elements from the slice s by the key returned by
+// the function fn like GroupBy, using the given number of workers. The keys
+// are computed concurrently on contiguous shards of the slice, and the
+// elements are partitioned by a hash of their key, such that each worker
+// groups the elements of a distinct set of keys. The elements of each group
+// retain their order in s exactly like with GroupBy.
+//
+// The function fn is called concurrently and must be safe for concurrent
+// use. If workers is not positive, runtime.GOMAXPROCS(0) workers are used.
+// At most the square root of the length of s workers are used.
+func ParallelGroupBy[E any, K comparable](s []E, fn func(e E) K, workers int) map[K][]E {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	// Each shard is partitioned once per worker, so limit the number of
+	// workers to keep the partitions linear in the length of s.
+	if max := int(math.Sqrt(float64(len(s)))); workers > max {
+		workers = max
+	}
+	shards := shard(s, workers)
+	if len(shards) == 1 {
+		return GroupBy(s, fn)
+	}
+
+	// Compute the keys and partition the indices of each shard by the hash
+	// of their key, retaining their order.
+	w := len(shards)
+	seed := maphash.MakeSeed()
+	keys := make([]K, len(s))
+	parts := make([][][]int, w)
+	offsets := ScanLeft(shards, 0, func(acc int, shard []E) int { return acc + len(shard) })
+	parallel(w, func(i int) {
+		parts[i] = make([][]int, w)
+		for j, e := range shards[i] {
+			idx := offsets[i] + j
+			keys[idx] = fn(e)
+			p := hashKey(seed, keys[idx]) % uint64(w)
+			parts[i][p] = append(parts[i][p], idx)
+		}
+	})
+
+	// Group each partition, visiting the shards in order.
+	groups := make([]map[K][]E, w)
+	parallel(w, func(p int) {
+		m := make(map[K][]E)
+		for i := range shards {
+			for _, idx := range parts[i][p] {
+				m[keys[idx]] = append(m[keys[idx]], s[idx])
+			}
+		}
+		groups[p] = m
+	})
+
+	n := SumOf(groups, func(g map[K][]E) int { return len(g) })
+	m := make(map[K][]E, n)
+	for _, g := range groups {
+		for k, v := range g {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+// shard splits the slice s into at most workers non-empty contiguous shards
+// of balanced size. If workers is not positive, runtime.GOMAXPROCS(0) is used.
+func shard[E any](s []E, workers int) [][]E {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(s) {
+		workers = len(s)
+	}
+	if workers == 0 {
+		return [][]E{s}
+	}
+	return splitNView(s, workers)
+}
+
+// parallel calls the function fn concurrently for each index in [0, n)
+// and waits for all calls to return.
+func parallel(n int, fn func(i int)) {
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			defer wg.Done()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// hashKey returns a hash of the key k for the seed, such that equal keys
+// have equal hashes.
+func hashKey[K comparable](seed maphash.Seed, k K) uint64 {
+	switch k := any(k).(type) {
+	case int:
+		return mix64(uint64(k))
+	case int64:
+		return mix64(uint64(k))
+	case uint64:
+		return mix64(k)
+	}
+	var h maphash.Hash
+	h.SetSeed(seed)
+	if k, ok := any(k).(string); ok {
+		h.WriteString(k)
+	} else {
+		writeValue(&h, reflect.ValueOf(k))
+	}
+	return h.Sum64()
+}
+
+// mix64 returns a hash of the integer u using the finalizer of SplitMix64.
+func mix64(u uint64) uint64 {
+	u = (u ^ (u >> 30)) * 0xbf58476d1ce4e5b9
+	u = (u ^ (u >> 27)) * 0x94d049bb133111eb
+	return u ^ (u >> 31)
+}
+
+// writeValue writes the value v of a comparable type to the hash h, such
+// that equal values write equal bytes.
+func writeValue(h *maphash.Hash, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			h.WriteByte(1)
+		} else {
+			h.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(h, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(h, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(h, v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeFloat(h, real(v.Complex()))
+		writeFloat(h, imag(v.Complex()))
+	case reflect.String:
+		h.WriteString(v.String())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint64(h, uint64(v.Pointer()))
+	case reflect.Interface:
+		if !v.IsNil() {
+			writeValue(h, v.Elem())
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeValue(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			// Blank fields are ignored when comparing structs.
+			if v.Type().Field(i).Name != "_" {
+				writeValue(h, v.Field(i))
+			}
+		}
+	}
+}
+
+// writeUint64 writes the integer u to the hash h.
+func writeUint64(h *maphash.Hash, u uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], u)
+	h.Write(b[:])
+}
+
+// writeFloat writes the float f to the hash h, such that positive and
+// negative zero write equal bytes.
+func writeFloat(h *maphash.Hash, f float64) {
+	if f == 0 {
+		f = 0
+	}
+	writeUint64(h, math.Float64bits(f))
+}
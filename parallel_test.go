@@ -0,0 +1,76 @@
+package slices
+
+import (
+	"hash/maphash"
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestParallelReduce(t *testing.T) {
+	s := Map(make([]int, 10000), func(int) int { return 1 })
+	for _, workers := range []int{-1, 0, 1, 3, 64, 20000} {
+		assertEqual(t, 10000, ParallelReduce(s, func(acc, i int) int { return acc + i }, workers))
+	}
+
+	// Concatenation is associative but not commutative.
+	words := Map(rand.New(rand.NewSource(1)).Perm(100), strconv.Itoa)
+	concat := func(acc, s string) string { return acc + s }
+	assertEqual(t, Reduce(words, concat), ParallelReduce(words, concat, 7))
+	assertEqual(t, "a", ParallelReduce([]string{"a"}, concat, 4))
+
+	assertPanic(t, errEmptySlice, func() { ParallelReduce(nil, func(acc, i int) int { return acc + i }, 4) })
+}
+
+func TestParallelGroupBy(t *testing.T) {
+	s := rand.New(rand.NewSource(1)).Perm(10000)
+	mod := func(i int) int { return i % 7 }
+	for _, workers := range []int{-1, 1, 4, 64, 20000} {
+		assertEqual(t, GroupBy(s, mod), ParallelGroupBy(s, mod, workers))
+		assertEqual(t, GroupBy(s, strconv.Itoa), ParallelGroupBy(s, strconv.Itoa, workers))
+	}
+
+	// Keys of other types are partitioned by a hash of their value.
+	type point struct{ x, y float64 }
+	points := []point{{0, 1}, {math.Copysign(0, -1), 1}, {1, 0}, {0, 1}, {1, math.Copysign(0, -1)}}
+	id := func(p point) point { return p }
+	assertEqual(t, GroupBy(points, id), ParallelGroupBy(points, id, 3))
+
+	assertEqual(t, map[int][]int{}, ParallelGroupBy(nil, mod, 4))
+	assertEqual(t, map[int][]int{1: {1}}, ParallelGroupBy([]int{1}, mod, 4))
+}
+
+func TestHashKey(t *testing.T) {
+	type key struct {
+		name string
+		f    float64
+		_    int
+	}
+
+	seed := maphash.MakeSeed()
+	assertEqual(t, hashKey(seed, "a"), hashKey(seed, "a"))
+	assertEqual(t, hashKey(seed, 0.0), hashKey(seed, math.Copysign(0, -1)))
+	assertEqual(t, hashKey(seed, [2]int8{1, 2}), hashKey(seed, [2]int8{1, 2}))
+	assertEqual(t, hashKey(seed, key{"a", 0, 1}), hashKey(seed, key{"a", math.Copysign(0, -1), 2}))
+
+	p := new(int)
+	assertEqual(t, hashKey(seed, p), hashKey(seed, p))
+}
+
+func BenchmarkParallelGroupBy(b *testing.B) {
+	s := rand.New(rand.NewSource(1)).Perm(1000000)
+	for _, cardinality := range []int{16, 1000000} {
+		key := func(i int) int { return i % cardinality }
+		b.Run("GroupBy/"+strconv.Itoa(cardinality), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				GroupBy(s, key)
+			}
+		})
+		b.Run("ParallelGroupBy/"+strconv.Itoa(cardinality), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ParallelGroupBy(s, key, 0)
+			}
+		})
+	}
+}
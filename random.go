@@ -0,0 +1,257 @@
+package slices
+
+import (
+	"math"
+	"math/bits"
+	"math/rand"
+)
+
+// Source is a source of uniformly distributed random uint64 values.
+//
+// It is implemented by *rand.Rand of both math/rand and math/rand/v2, as
+// well as by the sources of math/rand/v2 such as *rand.PCG. Seeding the
+// source makes the functions using it deterministic.
+type Source interface {
+	Uint64() uint64
+}
+
+// globalSource is the Source used if nil is passed, backed by the top-level
+// functions of math/rand.
+type globalSource struct{}
+
+func (globalSource) Uint64() uint64 {
+	return rand.Uint64()
+}
+
+// sourceOrDefault returns the source src or the global source if src is nil.
+func sourceOrDefault(src Source) Source {
+	if src == nil {
+		return globalSource{}
+	}
+	return src
+}
+
+// intN returns a uniformly distributed random integer in [0, n) for n > 0,
+// using Lemire's multiply-shift method without modulo bias.
+func intN(src Source, n int) int {
+	un := uint64(n)
+	hi, lo := bits.Mul64(src.Uint64(), un)
+	if lo < un {
+		threshold := -un % un
+		for lo < threshold {
+			hi, lo = bits.Mul64(src.Uint64(), un)
+		}
+	}
+	return int(hi)
+}
+
+// Shuffle returns a newly allocated slice with the elements of the slice s
+// in a uniformly random order, drawn from the random source src.
+// If src is nil, the global source of math/rand is used.
+func Shuffle[E any](s []E, src Source) []E {
+	return ShuffleInPlace(clone(s), src)
+}
+
+// ShuffleInPlace returns the slice s with its elements in a uniformly random
+// order, drawn from the random source src using the Fisher-Yates algorithm.
+// If src is nil, the global source of math/rand is used.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func ShuffleInPlace[E any](s []E, src Source) []E {
+	src = sourceOrDefault(src)
+	for i := len(s) - 1; i > 0; i-- {
+		j := intN(src, i+1)
+		s[i], s[j] = s[j], s[i]
+	}
+	return s
+}
+
+// float64N returns a uniformly distributed random float64 in [0, 1).
+func float64N(src Source) float64 {
+	return float64(src.Uint64()>>11) / (1 << 53)
+}
+
+// Sample returns a newly allocated slice of k distinct elements of the slice
+// s chosen uniformly at random without replacement, drawn from the random
+// source src. If src is nil, the global source of math/rand is used.
+//
+// If k is greater than the length of s, all elements are returned in random
+// order. It runs in O(k) time and space.
+func Sample[E any](s []E, k int, src Source) []E {
+	src = sourceOrDefault(src)
+	k = clamp(k, len(s))
+	r := make([]E, k)
+	// Perform a partial Fisher-Yates shuffle on a virtual array of indices,
+	// only storing the positions which have been swapped.
+	swapped := make(map[int]int, k)
+	index := func(i int) int {
+		if j, ok := swapped[i]; ok {
+			return j
+		}
+		return i
+	}
+	for i := range r {
+		j := i + intN(src, len(s)-i)
+		vi, vj := index(i), index(j)
+		swapped[j] = vi
+		r[i] = s[vj]
+	}
+	return r
+}
+
+// SampleWithReplacement returns a newly allocated slice of k elements of the
+// slice s chosen uniformly at random with replacement, drawn from the random
+// source src. If src is nil, the global source of math/rand is used.
+//
+// If the slice is empty and k is positive, SampleWithReplacement will panic.
+func SampleWithReplacement[E any](s []E, k int, src Source) []E {
+	src = sourceOrDefault(src)
+	if k < 0 {
+		k = 0
+	}
+	if len(s) == 0 && k > 0 {
+		panic(errEmptySlice)
+	}
+	r := make([]E, k)
+	for i := range r {
+		r[i] = s[intN(src, len(s))]
+	}
+	return r
+}
+
+// Reservoir maintains a uniform random sample of fixed size over a stream
+// of elements of unknown length, using Vitter's Algorithm R.
+type Reservoir[E any] struct {
+	k     int
+	n     int
+	items []E
+	src   Source
+}
+
+// NewReservoir returns a Reservoir holding a sample of at most k elements,
+// drawn from the random source src. If src is nil, the global source of
+// math/rand is used.
+//
+// If k is not positive, NewReservoir will panic.
+func NewReservoir[E any](k int, src Source) *Reservoir[E] {
+	if k <= 0 {
+		panic(errInvalidSize)
+	}
+	return &Reservoir[E]{k: k, items: make([]E, 0, k), src: sourceOrDefault(src)}
+}
+
+// Add offers the element e to the reservoir. Each element added so far
+// is retained in the sample with equal probability.
+func (r *Reservoir[E]) Add(e E) {
+	r.n++
+	if len(r.items) < r.k {
+		r.items = append(r.items, e)
+		return
+	}
+	if j := intN(r.src, r.n); j < r.k {
+		r.items[j] = e
+	}
+}
+
+// Count returns the number of elements added to the reservoir.
+func (r *Reservoir[E]) Count() int {
+	return r.n
+}
+
+// Sample returns a newly allocated slice of the elements currently held by
+// the reservoir. It contains min(k, Count()) elements.
+func (r *Reservoir[E]) Sample() []E {
+	return clone(r.items)
+}
+
+// WeightedChoice returns a single element of the slice s chosen at random,
+// where the probability of each element is proportional to the weight
+// returned by the function fn, drawn from the random source src.
+// If src is nil, the global source of math/rand is used.
+//
+// If the slice is empty, an error is returned. If any weight is negative or
+// all weights are zero, an error is returned as well.
+func WeightedChoice[E any, N realNumber](s []E, fn func(e E) N, src Source) (zeroValue E, _ error) {
+	if len(s) == 0 {
+		return zeroValue, errEmptySlice
+	}
+	w := Map(s, func(e E) float64 { return float64(fn(e)) })
+	if Any(w, func(w float64) bool { return w < 0 }) {
+		return zeroValue, errInvalidWeight
+	}
+	total := SumOf(w, func(w float64) float64 { return w })
+	if total <= 0 {
+		return zeroValue, errInvalidWeight
+	}
+	x := float64N(sourceOrDefault(src)) * total
+	for i, w := range w {
+		if x < w {
+			return s[i], nil
+		}
+		x -= w
+	}
+	// Guard against floating point rounding by falling back to the last
+	// element with a positive weight.
+	i := len(w) - 1
+	for w[i] == 0 {
+		i--
+	}
+	return s[i], nil
+}
+
+// WeightedSample returns a newly allocated slice of k distinct elements of
+// the slice s chosen at random without replacement, where the probability of
+// each element is proportional to the weight returned by the function fn,
+// drawn from the random source src. If src is nil, the global source of
+// math/rand is used.
+//
+// It uses the algorithm by Efraimidis and Spirakis. Elements with a weight
+// of zero are never chosen, thus the result is shorter than k if less than
+// k elements have a positive weight. If any weight is negative,
+// an error is returned.
+func WeightedSample[E any, N realNumber](s []E, k int, fn func(e E) N, src Source) ([]E, error) {
+	src = sourceOrDefault(src)
+	keys := make([]Pair[float64, E], 0, len(s))
+	for _, e := range s {
+		w := float64(fn(e))
+		if w < 0 {
+			return nil, errInvalidWeight
+		}
+		if w == 0 {
+			continue
+		}
+		// The key u^(1/w) is compared in the logarithmic domain to
+		// avoid underflow for small weights.
+		u := 1 - float64N(src)
+		keys = append(keys, Pair[float64, E]{math.Log(u) / w, e})
+	}
+	k = clamp(k, len(keys))
+	keys = PartialSortInPlace(keys, k, func(a, b Pair[float64, E]) bool { return a.First > b.First })
+	return Map(keys[:k], func(p Pair[float64, E]) E { return p.Second }), nil
+}
+
+// StratifiedSample groups elements from the slice s by the key returned by
+// the function fn like GroupBy and samples k elements from each group
+// uniformly at random without replacement, drawn from the random source src.
+// If src is nil, the global source of math/rand is used.
+//
+// Groups with less than k elements are retained entirely in random order.
+// The groups are sampled in the order of their first occurrence, thus the
+// result is deterministic for a seeded source.
+func StratifiedSample[E any, K comparable](s []E, k int, fn func(e E) K, src Source) map[K][]E {
+	src = sourceOrDefault(src)
+	m := make(map[K][]E)
+	keys := make([]K, 0)
+	for _, e := range s {
+		key := fn(e)
+		if _, ok := m[key]; !ok {
+			keys = append(keys, key)
+		}
+		m[key] = append(m[key], e)
+	}
+	for _, key := range keys {
+		m[key] = Sample(m[key], k, src)
+	}
+	return m
+}
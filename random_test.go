@@ -0,0 +1,211 @@
+package slices
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"unsafe"
+)
+
+// chiSquare returns the chi-square statistic of the observed counts
+// against a uniform distribution.
+func chiSquare(counts []int) float64 {
+	total := SumOf(counts, func(c int) int { return c })
+	e := float64(total) / float64(len(counts))
+	return SumOf(counts, func(c int) float64 { return (float64(c) - e) * (float64(c) - e) / e })
+}
+
+func TestIntN(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	counts := make([]int, 7)
+	for i := 0; i < 70000; i++ {
+		n := intN(src, len(counts))
+		if n < 0 || n >= len(counts) {
+			t.Fatalf("Test %s: Expected value in [0, %d), Received `%d`", t.Name(), len(counts), n)
+		}
+		counts[n]++
+	}
+	// Critical value of the chi-square distribution with 6 degrees
+	// of freedom at a significance level of 0.001.
+	if x := chiSquare(counts); x > 22.46 {
+		t.Errorf("Test %s: Expected uniform distribution, Received %v (chi-square %.2f)", t.Name(), counts, x)
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	shuffled := Shuffle(s, rand.New(rand.NewSource(42)))
+	assertEqual(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, s)
+	assertEqual(t, shuffled, Shuffle(s, rand.New(rand.NewSource(42))))
+	if unsafe.Pointer(&s[0]) == unsafe.Pointer(&shuffled[0]) {
+		t.Errorf("Test %s: Expected s1 and s2 to not be the same slice", t.Name())
+	}
+
+	sort.Ints(shuffled)
+	assertEqual(t, s, shuffled)
+	assertEqual(t, 8, len(Shuffle(s, nil)))
+	assertEqual(t, []int{}, Shuffle([]int{}, nil))
+}
+
+func TestShuffleInPlace(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	permutations := map[[3]int]int{}
+	for i := 0; i < 60000; i++ {
+		s := ShuffleInPlace([]int{0, 1, 2}, src)
+		permutations[[3]int{s[0], s[1], s[2]}]++
+	}
+	assertEqual(t, 6, len(permutations))
+
+	counts := make([]int, 0, len(permutations))
+	for _, c := range permutations {
+		counts = append(counts, c)
+	}
+	// Critical value of the chi-square distribution with 5 degrees
+	// of freedom at a significance level of 0.001.
+	if x := chiSquare(counts); x > 20.52 {
+		t.Errorf("Test %s: Expected uniform distribution, Received %v (chi-square %.2f)", t.Name(), permutations, x)
+	}
+
+	s := []int{1, 2, 3}
+	shuffled := ShuffleInPlace(s, src)
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&shuffled[0]))
+}
+
+func TestSample(t *testing.T) {
+	s := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	sample := Sample(s, 4, rand.New(rand.NewSource(1)))
+	assertEqual(t, 4, len(sample))
+	assertEqual(t, sample, Unique(sample))
+	assertEqual(t, true, All(sample, func(i int) bool { return Contains(s, i) }))
+	assertEqual(t, sample, Sample(s, 4, rand.New(rand.NewSource(1))))
+
+	all := Sample(s, 20, nil)
+	sort.Ints(all)
+	assertEqual(t, s, all)
+	assertEqual(t, []int{}, Sample(s, -1, nil))
+	assertEqual(t, []int{}, Sample([]int{}, 3, nil))
+
+	src := rand.New(rand.NewSource(1))
+	counts := make([]int, len(s))
+	for i := 0; i < 10000; i++ {
+		for _, e := range Sample(s, 3, src) {
+			counts[e]++
+		}
+	}
+	// Critical value of the chi-square distribution with 9 degrees
+	// of freedom at a significance level of 0.001.
+	if x := chiSquare(counts); x > 27.88 {
+		t.Errorf("Test %s: Expected uniform distribution, Received %v (chi-square %.2f)", t.Name(), counts, x)
+	}
+}
+
+func TestSampleWithReplacement(t *testing.T) {
+	s := []int{1, 2, 3}
+	sample := SampleWithReplacement(s, 10, rand.New(rand.NewSource(1)))
+	assertEqual(t, 10, len(sample))
+	assertEqual(t, true, All(sample, func(i int) bool { return Contains(s, i) }))
+	assertEqual(t, []int{}, SampleWithReplacement(s, -1, nil))
+	assertEqual(t, []int{}, SampleWithReplacement([]int{}, 0, nil))
+
+	assertPanic(t, errEmptySlice, func() { SampleWithReplacement([]int{}, 1, nil) })
+}
+
+func TestReservoir(t *testing.T) {
+	r := NewReservoir[int](3, rand.New(rand.NewSource(1)))
+	assertEqual(t, []int{}, r.Sample())
+	r.Add(1)
+	r.Add(2)
+	assertEqual(t, []int{1, 2}, r.Sample())
+	assertEqual(t, 2, r.Count())
+
+	src := rand.New(rand.NewSource(1))
+	counts := make([]int, 10)
+	for i := 0; i < 10000; i++ {
+		r := NewReservoir[int](2, src)
+		for e := range counts {
+			r.Add(e)
+		}
+		for _, e := range r.Sample() {
+			counts[e]++
+		}
+	}
+	// Critical value of the chi-square distribution with 9 degrees
+	// of freedom at a significance level of 0.001.
+	if x := chiSquare(counts); x > 27.88 {
+		t.Errorf("Test %s: Expected uniform distribution, Received %v (chi-square %.2f)", t.Name(), counts, x)
+	}
+
+	assertPanic(t, errInvalidSize, func() { NewReservoir[int](0, nil) })
+}
+
+func TestWeightedChoice(t *testing.T) {
+	type Item struct {
+		name   string
+		weight int
+	}
+
+	s := []Item{{"a", 1}, {"b", 0}, {"c", 3}}
+	src := rand.New(rand.NewSource(1))
+	counts := map[string]int{}
+	for i := 0; i < 40000; i++ {
+		e, err := WeightedChoice(s, func(e Item) int { return e.weight }, src)
+		assertNil(t, err)
+		counts[e.name]++
+	}
+	assertEqual(t, 0, counts["b"])
+	if ratio := float64(counts["c"]) / float64(counts["a"]); ratio < 2.8 || ratio > 3.2 {
+		t.Errorf("Test %s: Expected ratio of 3, Received %v (ratio %.2f)", t.Name(), counts, ratio)
+	}
+
+	_, err := WeightedChoice([]Item{}, func(e Item) int { return e.weight }, nil)
+	assertEqual(t, errEmptySlice, err)
+	_, err = WeightedChoice([]Item{{"a", 0}}, func(e Item) int { return e.weight }, nil)
+	assertEqual(t, errInvalidWeight, err)
+	_, err = WeightedChoice([]Item{{"a", 2}, {"b", -1}}, func(e Item) int { return e.weight }, nil)
+	assertEqual(t, errInvalidWeight, err)
+}
+
+func TestWeightedSample(t *testing.T) {
+	weight := func(i int) float64 { return float64(i) }
+
+	sample, err := WeightedSample([]int{0, 1, 2, 3}, 5, weight, rand.New(rand.NewSource(1)))
+	assertNil(t, err)
+	sort.Ints(sample)
+	assertEqual(t, []int{1, 2, 3}, sample)
+
+	src := rand.New(rand.NewSource(1))
+	counts := make([]int, 4)
+	for i := 0; i < 20000; i++ {
+		sample, err := WeightedSample([]int{0, 1, 2, 3}, 1, weight, src)
+		assertNil(t, err)
+		counts[sample[0]]++
+	}
+	assertEqual(t, 0, counts[0])
+	if counts[1] > counts[2] || counts[2] > counts[3] {
+		t.Errorf("Test %s: Expected counts to increase with weight, Received %v", t.Name(), counts)
+	}
+
+	_, err = WeightedSample([]int{1, -1}, 1, weight, nil)
+	assertEqual(t, errInvalidWeight, err)
+}
+
+func TestStratifiedSample(t *testing.T) {
+	type Host struct {
+		region string
+		id     int
+	}
+
+	s := []Host{{"eu", 1}, {"us", 2}, {"eu", 3}, {"eu", 4}, {"us", 5}, {"ap", 6}}
+	region := func(h Host) string { return h.region }
+	sample := StratifiedSample(s, 2, region, rand.New(rand.NewSource(1)))
+	assertEqual(t, 3, len(sample))
+	assertEqual(t, 2, len(sample["eu"]))
+	assertEqual(t, 2, len(sample["us"]))
+	assertEqual(t, []Host{{"ap", 6}}, sample["ap"])
+	assertEqual(t, true, All(sample["eu"], func(h Host) bool { return h.region == "eu" }))
+	assertEqual(t, sample, StratifiedSample(s, 2, region, rand.New(rand.NewSource(1))))
+
+	calls := 0
+	StratifiedSample(s, 2, func(h Host) string { calls++; return h.region }, nil)
+	assertEqual(t, len(s), calls)
+}
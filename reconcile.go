@@ -0,0 +1,54 @@
+package slices
+
+// KeyedDiff is the result of comparing two slices of elements by key.
+// Changed contains pairs of the old and the new element.
+type KeyedDiff[E any] struct {
+	Added     []E
+	Removed   []E
+	Changed   []Pair[E, E]
+	Unchanged []E
+}
+
+// DiffBy compares the slices old and new by the keys returned by the function
+// fn, e.g. to reconcile a desired with an actual state. Elements whose key
+// only occurs in new are added and those whose key only occurs in old are
+// removed. Elements whose key occurs in both are changed unless the function
+// eq returns true for the old and the new element.
+//
+// Added, Changed and Unchanged are ordered like the slice new, while Removed
+// is ordered like the slice old. Keys are expected to be unique within each
+// slice; like AssociateBy, the last old element of a duplicate key is used
+// for comparison.
+func DiffBy[E any, K comparable](old, new []E, fn func(e E) K, eq func(a, b E) bool) KeyedDiff[E] {
+	d := KeyedDiff[E]{
+		Added:     make([]E, 0),
+		Removed:   make([]E, 0),
+		Changed:   make([]Pair[E, E], 0),
+		Unchanged: make([]E, 0),
+	}
+	oldKeys := Map(old, fn)
+	m := make(map[K]E, len(old))
+	for i, o := range old {
+		m[oldKeys[i]] = o
+	}
+	keys := make(map[K]struct{}, len(new))
+	for _, n := range new {
+		k := fn(n)
+		keys[k] = struct{}{}
+		o, ok := m[k]
+		switch {
+		case !ok:
+			d.Added = append(d.Added, n)
+		case eq(o, n):
+			d.Unchanged = append(d.Unchanged, n)
+		default:
+			d.Changed = append(d.Changed, Pair[E, E]{o, n})
+		}
+	}
+	for i, o := range old {
+		if _, ok := keys[oldKeys[i]]; !ok {
+			d.Removed = append(d.Removed, o)
+		}
+	}
+	return d
+}
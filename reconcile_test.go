@@ -0,0 +1,57 @@
+package slices
+
+import "testing"
+
+func TestDiffBy(t *testing.T) {
+	type Deployment struct {
+		name     string
+		replicas int
+	}
+
+	name := func(d Deployment) string { return d.name }
+	eq := func(a, b Deployment) bool { return a == b }
+
+	tests := []struct {
+		old, new []Deployment
+		e        KeyedDiff[Deployment]
+	}{
+		{
+			old: nil,
+			new: nil,
+			e: KeyedDiff[Deployment]{
+				Added:     []Deployment{},
+				Removed:   []Deployment{},
+				Changed:   []Pair[Deployment, Deployment]{},
+				Unchanged: []Deployment{},
+			},
+		},
+		{
+			old: []Deployment{{"api", 3}, {"web", 2}, {"worker", 1}, {"cron", 1}},
+			new: []Deployment{{"worker", 4}, {"queue", 1}, {"api", 3}, {"cache", 2}, {"web", 2}},
+			e: KeyedDiff[Deployment]{
+				Added:     []Deployment{{"queue", 1}, {"cache", 2}},
+				Removed:   []Deployment{{"cron", 1}},
+				Changed:   []Pair[Deployment, Deployment]{{Deployment{"worker", 1}, Deployment{"worker", 4}}},
+				Unchanged: []Deployment{{"api", 3}, {"web", 2}},
+			},
+		},
+		{
+			old: []Deployment{{"api", 3}},
+			new: nil,
+			e: KeyedDiff[Deployment]{
+				Added:     []Deployment{},
+				Removed:   []Deployment{{"api", 3}},
+				Changed:   []Pair[Deployment, Deployment]{},
+				Unchanged: []Deployment{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, DiffBy(test.old, test.new, name, eq))
+
+		calls := 0
+		DiffBy(test.old, test.new, func(d Deployment) string { calls++; return d.name }, eq)
+		assertEqual(t, len(test.old)+len(test.new), calls)
+	}
+}
@@ -0,0 +1,59 @@
+package slices
+
+// Replace returns a newly allocated slice with the first n occurrences of
+// the value old in the slice s replaced by the value new, along with the
+// number of replacements. If n < 0, there is no limit on the number of
+// replacements.
+func Replace[E comparable](s []E, old, new E, n int) ([]E, uint) {
+	r := clone(s)
+	c := uint(0)
+	for i, e := range r {
+		if c == uint(n) {
+			break
+		}
+		if e == old {
+			r[i] = new
+			c++
+		}
+	}
+	return r, c
+}
+
+// ReplaceAll returns a newly allocated slice with all occurrences of the
+// value old in the slice s replaced by the value new, along with the number
+// of replacements.
+func ReplaceAll[E comparable](s []E, old, new E) ([]E, uint) {
+	return Replace(s, old, new, -1)
+}
+
+// ReplaceFunc returns a newly allocated slice where each element of the
+// slice s for which the predicate function pred returns true is replaced by
+// the result of calling the function fn on it, along with the number of
+// replacements.
+func ReplaceFunc[E any](s []E, pred func(e E) bool, fn func(e E) E) ([]E, uint) {
+	r := clone(s)
+	c := uint(0)
+	for i, e := range r {
+		if pred(e) {
+			r[i] = fn(e)
+			c++
+		}
+	}
+	return r, c
+}
+
+// UpdateWhere calls the function fn with a pointer to each element of the
+// slice s for which the predicate function pred returns true, allowing it to
+// modify the element in place. It returns the number of updated elements.
+//
+// It modifies the underlying array of slice e.
+func UpdateWhere[E any](s []E, pred func(e E) bool, fn func(e *E)) uint {
+	c := uint(0)
+	for i := range s {
+		if pred(s[i]) {
+			fn(&s[i])
+			c++
+		}
+	}
+	return c
+}
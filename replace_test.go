@@ -0,0 +1,68 @@
+package slices
+
+import "testing"
+
+func TestReplace(t *testing.T) {
+	tests := []struct {
+		s []int
+		n int
+		e []int
+		c uint
+	}{
+		{s: nil, n: -1, e: []int{}, c: 0},
+		{s: []int{1, 2, 1, 3, 1}, n: -1, e: []int{0, 2, 0, 3, 0}, c: 3},
+		{s: []int{1, 2, 1, 3, 1}, n: 2, e: []int{0, 2, 0, 3, 1}, c: 2},
+		{s: []int{1, 2, 1, 3, 1}, n: 0, e: []int{1, 2, 1, 3, 1}, c: 0},
+		{s: []int{2, 3}, n: 1, e: []int{2, 3}, c: 0},
+	}
+
+	for _, test := range tests {
+		r, c := Replace(test.s, 1, 0, test.n)
+		assertEqual(t, test.e, r)
+		assertEqual(t, test.c, c)
+	}
+
+	s := []int{1, 2, 1}
+	Replace(s, 1, 0, -1)
+	assertEqual(t, []int{1, 2, 1}, s)
+}
+
+func TestReplaceAll(t *testing.T) {
+	r, n := ReplaceAll([]string{"a", "b", "a"}, "a", "c")
+	assertEqual(t, []string{"c", "b", "c"}, r)
+	assertEqual(t, uint(2), n)
+}
+
+func TestReplaceFunc(t *testing.T) {
+	tests := []struct {
+		s, e []int
+		n    uint
+	}{
+		{s: nil, e: []int{}, n: 0},
+		{s: []int{1, 2, 3, 4, 5}, e: []int{1, 20, 3, 40, 5}, n: 2},
+	}
+
+	for _, test := range tests {
+		r, n := ReplaceFunc(test.s, func(i int) bool { return i%2 == 0 }, func(i int) int { return i * 10 })
+		assertEqual(t, test.e, r)
+		assertEqual(t, test.n, n)
+	}
+}
+
+func TestUpdateWhere(t *testing.T) {
+	type Person struct {
+		firstname, lastname string
+	}
+
+	s := []Person{{"Grace", "Hoper"}, {"Jacob", "Bernoulli"}, {"Johann", "Bernoulli"}}
+	n := UpdateWhere(s, func(p Person) bool { return p.lastname == "Hoper" }, func(p *Person) { p.lastname = "Hopper" })
+	assertEqual(t, uint(1), n)
+	assertEqual(t, []Person{{"Grace", "Hopper"}, {"Jacob", "Bernoulli"}, {"Johann", "Bernoulli"}}, s)
+
+	ps := []*Person{{"Grace", "Hoper"}, {"Jacob", "Bernoulli"}, {"Johann", "Bernoulli"}}
+	n = UpdateWhere(ps, func(p *Person) bool { return p.lastname == "Bernoulli" }, func(p **Person) { (*p).firstname = "Daniel" })
+	assertEqual(t, uint(2), n)
+	assertEqual(t, []*Person{{"Grace", "Hoper"}, {"Daniel", "Bernoulli"}, {"Daniel", "Bernoulli"}}, ps)
+
+	assertEqual(t, uint(0), UpdateWhere(nil, func(p Person) bool { return true }, func(p *Person) {}))
+}
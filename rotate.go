@@ -0,0 +1,47 @@
+package slices
+
+// RotateLeft returns a newly allocated slice with the elements of the slice
+// s rotated k positions to the left, i.e. the element at index k becomes the
+// first element. A negative k rotates to the right.
+func RotateLeft[E any](s []E, k int) []E {
+	return RotateLeftInPlace(clone(s), k)
+}
+
+// RotateLeftInPlace rotates the elements of the slice s k positions to the
+// left, i.e. the element at index k becomes the first element. A negative k
+// rotates to the right. It uses O(1) extra space.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func RotateLeftInPlace[E any](s []E, k int) []E {
+	if len(s) == 0 {
+		return s
+	}
+	k %= len(s)
+	if k < 0 {
+		k += len(s)
+	}
+	ReverseInPlace(s[:k])
+	ReverseInPlace(s[k:])
+	return ReverseInPlace(s)
+}
+
+// RotateRight returns a newly allocated slice with the elements of the slice
+// s rotated k positions to the right, i.e. the last k elements become the
+// first elements. A negative k rotates to the left.
+func RotateRight[E any](s []E, k int) []E {
+	return RotateRightInPlace(clone(s), k)
+}
+
+// RotateRightInPlace rotates the elements of the slice s k positions to the
+// right, i.e. the last k elements become the first elements. A negative k
+// rotates to the left. It uses O(1) extra space.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func RotateRightInPlace[E any](s []E, k int) []E {
+	if len(s) == 0 {
+		return s
+	}
+	return RotateLeftInPlace(s, -(k % len(s)))
+}
@@ -0,0 +1,60 @@
+package slices
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestRotateLeft(t *testing.T) {
+	tests := []struct {
+		s []int
+		k int
+		e []int
+	}{
+		{s: nil, k: 1, e: []int{}},
+		{s: []int{1, 2, 3, 4, 5}, k: 0, e: []int{1, 2, 3, 4, 5}},
+		{s: []int{1, 2, 3, 4, 5}, k: 2, e: []int{3, 4, 5, 1, 2}},
+		{s: []int{1, 2, 3, 4, 5}, k: 7, e: []int{3, 4, 5, 1, 2}},
+		{s: []int{1, 2, 3, 4, 5}, k: -1, e: []int{5, 1, 2, 3, 4}},
+		{s: []int{1, 2, 3, 4, 5}, k: 5, e: []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, test := range tests {
+		rotated := RotateLeft(test.s, test.k)
+		assertEqual(t, test.e, rotated)
+		if len(rotated) > 0 && unsafe.Pointer(&test.s[0]) == unsafe.Pointer(&rotated[0]) {
+			t.Errorf("Test %s: Expected s1 and s2 to not be the same slice", t.Name())
+		}
+	}
+}
+
+func TestRotateLeftInPlace(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	rotated := RotateLeftInPlace(s, 1)
+	assertEqual(t, []int{2, 3, 4, 5, 1}, rotated)
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&rotated[0]))
+}
+
+func TestRotateRight(t *testing.T) {
+	tests := []struct {
+		s []int
+		k int
+		e []int
+	}{
+		{s: nil, k: 1, e: []int{}},
+		{s: []int{1, 2, 3, 4, 5}, k: 2, e: []int{4, 5, 1, 2, 3}},
+		{s: []int{1, 2, 3, 4, 5}, k: 12, e: []int{4, 5, 1, 2, 3}},
+		{s: []int{1, 2, 3, 4, 5}, k: -1, e: []int{2, 3, 4, 5, 1}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, RotateRight(test.s, test.k))
+	}
+}
+
+func TestRotateRightInPlace(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	rotated := RotateRightInPlace(s, 1)
+	assertEqual(t, []int{5, 1, 2, 3, 4}, rotated)
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&rotated[0]))
+}
@@ -0,0 +1,162 @@
+package slices
+
+// IndexSlice returns the index of the first occurrence of the sequence sub
+// in the slice s, or -1 if not present. An empty sequence is found at
+// index 0.
+//
+// It uses the Knuth-Morris-Pratt algorithm and runs in O(n+m) time.
+func IndexSlice[E comparable](s, sub []E) int {
+	if m := matches(s, sub, 1); len(m) > 0 {
+		return m[0]
+	}
+	return -1
+}
+
+// LastIndexSlice returns the index of the last occurrence of the sequence
+// sub in the slice s, or -1 if not present. An empty sequence is found at
+// index len(s).
+//
+// It uses the Knuth-Morris-Pratt algorithm and runs in O(n+m) time.
+func LastIndexSlice[E comparable](s, sub []E) int {
+	n, m := len(s), len(sub)
+	if m == 0 {
+		return n
+	}
+	// Match the reversed sequence against the reversed slice.
+	f := kmpTable(m, func(i int) E { return sub[m-1-i] })
+	k := 0
+	for i := n - 1; i >= 0; i-- {
+		for k > 0 && s[i] != sub[m-1-k] {
+			k = f[k-1]
+		}
+		if s[i] == sub[m-1-k] {
+			k++
+		}
+		if k == m {
+			return i
+		}
+	}
+	return -1
+}
+
+// ContainsSlice reports whether the sequence sub is present in the slice s.
+func ContainsSlice[E comparable](s, sub []E) bool {
+	return IndexSlice(s, sub) >= 0
+}
+
+// CountSlice returns an integer value indicating how many non-overlapping
+// occurrences of the sequence sub are present in the slice s.
+// If sub is empty, CountSlice returns 1 + len(s) like strings.Count.
+func CountSlice[E comparable](s, sub []E) uint {
+	if len(sub) == 0 {
+		return uint(len(s) + 1)
+	}
+	return uint(len(matches(s, sub, -1)))
+}
+
+// HasPrefix reports whether the slice s begins with the sequence prefix.
+func HasPrefix[E comparable](s, prefix []E) bool {
+	return len(s) >= len(prefix) && equal(s[:len(prefix)], prefix)
+}
+
+// HasSuffix reports whether the slice s ends with the sequence suffix.
+func HasSuffix[E comparable](s, suffix []E) bool {
+	return len(s) >= len(suffix) && equal(s[len(s)-len(suffix):], suffix)
+}
+
+// ReplaceSlice returns a newly allocated slice with the first n
+// non-overlapping occurrences of the sequence old in the slice s replaced by
+// the sequence new. If n < 0, there is no limit on the number of
+// replacements.
+//
+// If old is empty, it matches at the beginning of the slice and after each
+// element like strings.Replace, yielding up to len(s)+1 replacements.
+func ReplaceSlice[E comparable](s, old, new []E, n int) []E {
+	var m []int
+	if len(old) == 0 {
+		c := len(s) + 1
+		if n >= 0 && n < c {
+			c = n
+		}
+		m = make([]int, c)
+		for i := range m {
+			m[i] = i
+		}
+	} else {
+		m = matches(s, old, n)
+	}
+	r := make([]E, 0, len(s)+len(m)*(len(new)-len(old)))
+	j := 0
+	for _, i := range m {
+		r = append(r, s[j:i]...)
+		r = append(r, new...)
+		j = i + len(old)
+	}
+	return append(r, s[j:]...)
+}
+
+// ReplaceAllSlice returns a newly allocated slice with all non-overlapping
+// occurrences of the sequence old in the slice s replaced by the sequence new.
+func ReplaceAllSlice[E comparable](s, old, new []E) []E {
+	return ReplaceSlice(s, old, new, -1)
+}
+
+// equal reports whether the slices s1 and s2 have the same length and
+// contain the same elements in the same order.
+func equal[E comparable](s1, s2 []E) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for i, e := range s1 {
+		if e != s2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// matches returns the start indices of the first n non-overlapping
+// occurrences of the non-empty sequence sub in the slice s. If n < 0,
+// all occurrences are returned.
+func matches[E comparable](s, sub []E, n int) []int {
+	r := make([]int, 0)
+	m := len(sub)
+	if m == 0 {
+		return append(r, 0)
+	}
+	f := kmpTable(m, func(i int) E { return sub[i] })
+	k := 0
+	for i, e := range s {
+		if len(r) == n {
+			break
+		}
+		for k > 0 && e != sub[k] {
+			k = f[k-1]
+		}
+		if e == sub[k] {
+			k++
+		}
+		if k == m {
+			r = append(r, i-m+1)
+			k = 0
+		}
+	}
+	return r
+}
+
+// kmpTable returns the Knuth-Morris-Pratt failure function of the sequence
+// of length m, whose elements are retrieved by the function at.
+func kmpTable[E comparable](m int, at func(i int) E) []int {
+	f := make([]int, m)
+	k := 0
+	for i := 1; i < m; i++ {
+		for k > 0 && at(i) != at(k) {
+			k = f[k-1]
+		}
+		if at(i) == at(k) {
+			k++
+		}
+		f[i] = k
+	}
+	return f
+}
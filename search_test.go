@@ -0,0 +1,116 @@
+package slices
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIndexSlice(t *testing.T) {
+	tests := []struct {
+		s, sub []int
+		i      int
+	}{
+		{s: nil, sub: nil, i: 0},
+		{s: nil, sub: []int{1}, i: -1},
+		{s: []int{1, 2, 3}, sub: nil, i: 0},
+		{s: []int{1, 2, 3}, sub: []int{2, 3}, i: 1},
+		{s: []int{1, 2, 1, 2, 1, 2, 3}, sub: []int{1, 2, 1, 2, 3}, i: 2},
+		{s: []int{1, 2, 3}, sub: []int{1, 2, 3, 4}, i: -1},
+		{s: []int{1, 2, 3}, sub: []int{3, 2}, i: -1},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.i, IndexSlice(test.s, test.sub))
+	}
+}
+
+func TestLastIndexSlice(t *testing.T) {
+	tests := []struct {
+		s, sub []int
+		i      int
+	}{
+		{s: nil, sub: nil, i: 0},
+		{s: []int{1, 2, 3}, sub: nil, i: 3},
+		{s: []int{1, 2, 1, 2, 3}, sub: []int{1, 2}, i: 2},
+		{s: []int{1, 1, 1}, sub: []int{1, 1}, i: 1},
+		{s: []int{3, 1, 2, 1, 2, 1}, sub: []int{3, 1, 2, 1, 2}, i: 0},
+		{s: []int{1, 2, 3}, sub: []int{3, 2}, i: -1},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.i, LastIndexSlice(test.s, test.sub))
+	}
+}
+
+func TestContainsSlice(t *testing.T) {
+	assertEqual(t, true, ContainsSlice([]byte("hello world"), []byte("o w")))
+	assertEqual(t, false, ContainsSlice([]byte("hello world"), []byte("ow")))
+}
+
+func TestCountSlice(t *testing.T) {
+	tests := []string{"", "a", "aaaa", "abab", "banana"}
+	subs := []string{"", "a", "aa", "ab", "ana"}
+
+	for _, s := range tests {
+		for _, sub := range subs {
+			assertEqual(t, uint(strings.Count(s, sub)), CountSlice([]byte(s), []byte(sub)))
+		}
+	}
+}
+
+func TestHasPrefix(t *testing.T) {
+	tests := []struct {
+		s, prefix []int
+		e         bool
+	}{
+		{s: nil, prefix: nil, e: true},
+		{s: []int{1, 2}, prefix: []int{1}, e: true},
+		{s: []int{1, 2}, prefix: []int{2}, e: false},
+		{s: []int{1, 2}, prefix: []int{1, 2, 3}, e: false},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, HasPrefix(test.s, test.prefix))
+	}
+}
+
+func TestHasSuffix(t *testing.T) {
+	tests := []struct {
+		s, suffix []int
+		e         bool
+	}{
+		{s: nil, suffix: nil, e: true},
+		{s: []int{1, 2}, suffix: []int{2}, e: true},
+		{s: []int{1, 2}, suffix: []int{1}, e: false},
+		{s: []int{1, 2}, suffix: []int{0, 1, 2}, e: false},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, HasSuffix(test.s, test.suffix))
+	}
+}
+
+func TestReplaceSlice(t *testing.T) {
+	tests := []struct {
+		s, old, new string
+		n           int
+	}{
+		{s: "", old: "a", new: "b", n: -1},
+		{s: "banana", old: "a", new: "o", n: 2},
+		{s: "banana", old: "ana", new: "", n: -1},
+		{s: "aaaa", old: "aa", new: "b", n: -1},
+		{s: "abc", old: "", new: "-", n: -1},
+		{s: "abc", old: "", new: "-", n: 2},
+		{s: "abc", old: "b", new: "xyz", n: 0},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, []byte(strings.Replace(test.s, test.old, test.new, test.n)), ReplaceSlice([]byte(test.s), []byte(test.old), []byte(test.new), test.n))
+	}
+}
+
+func TestReplaceAllSlice(t *testing.T) {
+	s := []int{0xFF, 1, 2, 0xFF, 0xFF, 3}
+	assertEqual(t, []int{0, 0, 1, 2, 0, 0, 0, 0, 3}, ReplaceAllSlice(s, []int{0xFF}, []int{0, 0}))
+	assertEqual(t, []int{0xFF, 1, 2, 0xFF, 0xFF, 3}, s)
+}
@@ -0,0 +1,190 @@
+package slices
+
+// NthElement returns a copy of the slice s rearranged such that the element
+// at index k is the element which would be in that position if the slice were
+// sorted by the function less. All elements before index k are less than or
+// equal to it and all elements after index k are greater than or equal to it.
+//
+// It runs in O(n) time on average and in the worst case.
+// If k is not a valid index of the slice s, NthElement will panic.
+func NthElement[E any](s []E, k int, less func(a, b E) bool) []E {
+	r := make([]E, len(s))
+	copy(r, s)
+	return NthElementInPlace(r, k, less)
+}
+
+// NthElementInPlace rearranges the slice s such that the element at index k
+// is the element which would be in that position if the slice were sorted by
+// the function less. All elements before index k are less than or equal to it
+// and all elements after index k are greater than or equal to it.
+//
+// It runs in O(n) time on average and in the worst case.
+// If k is not a valid index of the slice s, NthElementInPlace will panic.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func NthElementInPlace[E any](s []E, k int, less func(a, b E) bool) []E {
+	if k < 0 || k >= len(s) {
+		panic(errIndexOutOfRange)
+	}
+	introselect(s, k, less)
+	return s
+}
+
+// PartialSort returns a copy of the slice s rearranged such that the first k
+// elements are the k smallest elements of the slice sorted by the function
+// less. The order of the remaining elements is unspecified.
+//
+// It runs in O(n + k log k) time.
+// If k is negative or greater than the length of s, PartialSort will panic.
+func PartialSort[E any](s []E, k int, less func(a, b E) bool) []E {
+	r := make([]E, len(s))
+	copy(r, s)
+	return PartialSortInPlace(r, k, less)
+}
+
+// PartialSortInPlace rearranges the slice s such that the first k elements
+// are the k smallest elements of the slice sorted by the function less.
+// The order of the remaining elements is unspecified.
+//
+// It runs in O(n + k log k) time.
+// If k is negative or greater than the length of s, PartialSortInPlace will panic.
+//
+// It modifies the underlying array of slice e. Thus, this method should only
+// be used if the passed slice e is not used afterwards!
+func PartialSortInPlace[E any](s []E, k int, less func(a, b E) bool) []E {
+	if k < 0 || k > len(s) {
+		panic(errIndexOutOfRange)
+	}
+	if k == 0 {
+		return s
+	}
+	if k == len(s) {
+		heapSort(s, less)
+		return s
+	}
+	introselect(s, k-1, less)
+	heapSort(s[:k-1], less)
+	return s
+}
+
+// introselect moves the k-th smallest element of s into position k, using
+// quickselect with median-of-three pivots. Once the partitioning work exceeds
+// a linear budget, it falls back to median-of-medians pivots, which bounds
+// the worst case to O(n).
+func introselect[E any](s []E, k int, less func(a, b E) bool) {
+	lo, hi := 0, len(s)
+	budget := 4 * len(s)
+	for hi-lo > 12 {
+		var p int
+		if budget > 0 {
+			p = medianOfThree(s, lo, lo+(hi-lo)/2, hi-1, less)
+		} else {
+			p = medianOfMedians(s, lo, hi, less)
+		}
+		budget -= hi - lo
+		lt, gt := partition3(s, lo, hi, p, less)
+		switch {
+		case k < lt:
+			hi = lt
+		case k >= gt:
+			lo = gt
+		default:
+			return
+		}
+	}
+	insertionSort(s[lo:hi], less)
+}
+
+// partition3 partitions s[lo:hi] around the value at index p such that
+// s[lo:lt] < pivot, s[lt:gt] == pivot and s[gt:hi] > pivot.
+func partition3[E any](s []E, lo, hi, p int, less func(a, b E) bool) (lt, gt int) {
+	v := s[p]
+	lt, gt = lo, hi
+	for i := lo; i < gt; {
+		switch {
+		case less(s[i], v):
+			s[lt], s[i] = s[i], s[lt]
+			lt++
+			i++
+		case less(v, s[i]):
+			gt--
+			s[i], s[gt] = s[gt], s[i]
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+// medianOfThree returns the index of the median of s[a], s[b] and s[c].
+func medianOfThree[E any](s []E, a, b, c int, less func(a, b E) bool) int {
+	if less(s[b], s[a]) {
+		a, b = b, a
+	}
+	if less(s[c], s[b]) {
+		b = c
+		if less(s[b], s[a]) {
+			b = a
+		}
+	}
+	return b
+}
+
+// medianOfMedians returns the index of an element of s[lo:hi] which is
+// guaranteed to be greater than and less than at least 30% of the elements.
+// It moves the medians of groups of five elements to the front of the range.
+func medianOfMedians[E any](s []E, lo, hi int, less func(a, b E) bool) int {
+	n := lo
+	for i := lo; i < hi; i += 5 {
+		j := i + 5
+		if j > hi {
+			j = hi
+		}
+		insertionSort(s[i:j], less)
+		m := i + (j-i)/2
+		s[n], s[m] = s[m], s[n]
+		n++
+	}
+	m := lo + (n-lo)/2
+	introselect(s[lo:n], m-lo, less)
+	return m
+}
+
+// insertionSort sorts the slice s by the function less.
+func insertionSort[E any](s []E, less func(a, b E) bool) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && less(s[j], s[j-1]); j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
+
+// heapSort sorts the slice s by the function less.
+func heapSort[E any](s []E, less func(a, b E) bool) {
+	for i := len(s)/2 - 1; i >= 0; i-- {
+		siftDown(s, i, len(s), less)
+	}
+	for i := len(s) - 1; i > 0; i-- {
+		s[0], s[i] = s[i], s[0]
+		siftDown(s, 0, i, less)
+	}
+}
+
+// siftDown restores the max-heap property of s[:n] for the element at index i.
+func siftDown[E any](s []E, i, n int, less func(a, b E) bool) {
+	for {
+		c := 2*i + 1
+		if c >= n {
+			return
+		}
+		if c+1 < n && less(s[c], s[c+1]) {
+			c++
+		}
+		if !less(s[i], s[c]) {
+			return
+		}
+		s[i], s[c] = s[c], s[i]
+		i = c
+	}
+}
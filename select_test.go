@@ -0,0 +1,113 @@
+package slices
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"unsafe"
+)
+
+func lessInt(a, b int) bool { return a < b }
+
+func TestNthElement(t *testing.T) {
+	tests := []struct {
+		s    []int
+		k, e int
+	}{
+		{s: []int{1}, k: 0, e: 1},
+		{s: []int{3, 1, 2}, k: 0, e: 1},
+		{s: []int{3, 1, 2}, k: 2, e: 3},
+		{s: []int{5, 4, 3, 2, 1, 0, 9, 8, 7, 6, 15, 14, 13, 12, 11, 10}, k: 7, e: 7},
+		{s: []int{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 3}, k: 13, e: 2},
+	}
+
+	for _, test := range tests {
+		s := make([]int, len(test.s))
+		copy(s, test.s)
+		r := NthElement(test.s, test.k, lessInt)
+		assertEqual(t, test.e, r[test.k])
+		assertEqual(t, s, test.s)
+		if unsafe.Pointer(&test.s[0]) == unsafe.Pointer(&r[0]) {
+			t.Errorf("Test %s: Expected s1 and s2 to not be the same slice", t.Name())
+		}
+	}
+
+	assertPanic(t, errIndexOutOfRange, func() { NthElement(nil, 0, lessInt) })
+	assertPanic(t, errIndexOutOfRange, func() { NthElement([]int{1, 2}, 2, lessInt) })
+	assertPanic(t, errIndexOutOfRange, func() { NthElement([]int{1, 2}, -1, lessInt) })
+}
+
+func TestNthElementInPlace(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	inputs := map[string]func(n int) []int{
+		"random": func(n int) []int { return rnd.Perm(n) },
+		"sorted": func(n int) []int {
+			s := rnd.Perm(n)
+			sort.Ints(s)
+			return s
+		},
+		"duplicates": func(n int) []int { return Map(rnd.Perm(n), func(i int) int { return i % 7 }) },
+	}
+
+	for name, input := range inputs {
+		for _, n := range []int{1, 13, 100, 1000} {
+			s := input(n)
+			sorted := append([]int(nil), s...)
+			sort.Ints(sorted)
+			for _, k := range []int{0, n / 3, n / 2, n - 1} {
+				r := NthElementInPlace(s, k, lessInt)
+				assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&r[0]))
+				if r[k] != sorted[k] {
+					t.Fatalf("Test %s: %s n=%d k=%d: Expected `%d`, Received `%d`", t.Name(), name, n, k, sorted[k], r[k])
+				}
+				for i := range r {
+					if (i < k && r[i] > r[k]) || (i > k && r[i] < r[k]) {
+						t.Fatalf("Test %s: %s n=%d k=%d: element %d at index %d is not partitioned", t.Name(), name, n, k, r[i], i)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestMedianOfMedians(t *testing.T) {
+	s := rand.New(rand.NewSource(1)).Perm(1000)
+	p := medianOfMedians(s, 0, len(s), lessInt)
+	lt := Count(s, func(v int) bool { return v < s[p] })
+	gt := Count(s, func(v int) bool { return v > s[p] })
+	if lt < 300 || gt < 300 {
+		t.Errorf("Test %s: Expected pivot to split at least 30%%, Received %d < pivot < %d", t.Name(), lt, gt)
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	tests := []struct {
+		s []int
+		k int
+		e []int
+	}{
+		{s: []int{}, k: 0, e: []int{}},
+		{s: []int{3, 1, 2}, k: 0, e: []int{}},
+		{s: []int{3, 1, 2}, k: 1, e: []int{1}},
+		{s: []int{3, 1, 2}, k: 3, e: []int{1, 2, 3}},
+		{s: []int{9, 3, 7, 1, 8, 2, 6, 4, 5, 0, 19, 13, 17, 11, 18, 12, 16, 14, 15, 10}, k: 5, e: []int{0, 1, 2, 3, 4}},
+	}
+
+	for _, test := range tests {
+		s := make([]int, len(test.s))
+		copy(s, test.s)
+		r := PartialSort(test.s, test.k, lessInt)
+		assertEqual(t, test.e, r[:test.k])
+		assertEqual(t, s, test.s)
+	}
+
+	assertPanic(t, errIndexOutOfRange, func() { PartialSort([]int{1, 2}, 3, lessInt) })
+	assertPanic(t, errIndexOutOfRange, func() { PartialSort([]int{1, 2}, -1, lessInt) })
+}
+
+func TestPartialSortInPlace(t *testing.T) {
+	s := rand.New(rand.NewSource(1)).Perm(100)
+	r := PartialSortInPlace(s, 10, lessInt)
+	assertEqual(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, r[:10])
+	assertEqual(t, unsafe.Pointer(&s[0]), unsafe.Pointer(&r[0]))
+}
@@ -0,0 +1,137 @@
+package slices
+
+import "math"
+
+// Jaccard returns the Jaccard index of the unique elements of the slices s1
+// and s2, i.e. the size of their intersection divided by the size of their
+// union. It is 1 if both slices are empty.
+func Jaccard[E comparable](s1, s2 []E) float64 {
+	return JaccardBy(s1, s2, identity[E])
+}
+
+// JaccardBy returns the Jaccard index of the unique keys returned by the
+// function fn for the elements of the slices s1 and s2, i.e. the size of
+// their intersection divided by the size of their union. It is 1 if both
+// slices are empty.
+func JaccardBy[E any, K comparable](s1, s2 []E, fn func(e E) K) float64 {
+	n1, n2, i := overlap(s1, s2, fn)
+	if n1 == 0 && n2 == 0 {
+		return 1
+	}
+	return float64(i) / float64(n1+n2-i)
+}
+
+// SorensenDice returns the Sørensen-Dice coefficient of the unique elements
+// of the slices s1 and s2, i.e. twice the size of their intersection divided
+// by the sum of their sizes. It is 1 if both slices are empty.
+func SorensenDice[E comparable](s1, s2 []E) float64 {
+	return SorensenDiceBy(s1, s2, identity[E])
+}
+
+// SorensenDiceBy returns the Sørensen-Dice coefficient of the unique keys
+// returned by the function fn for the elements of the slices s1 and s2, i.e.
+// twice the size of their intersection divided by the sum of their sizes.
+// It is 1 if both slices are empty.
+func SorensenDiceBy[E any, K comparable](s1, s2 []E, fn func(e E) K) float64 {
+	n1, n2, i := overlap(s1, s2, fn)
+	if n1 == 0 && n2 == 0 {
+		return 1
+	}
+	return 2 * float64(i) / float64(n1+n2)
+}
+
+// OverlapCoefficient returns the overlap coefficient of the unique elements
+// of the slices s1 and s2, i.e. the size of their intersection divided by
+// the size of the smaller set. It is 1 if both slices are empty and 0 if
+// only one of them is empty.
+func OverlapCoefficient[E comparable](s1, s2 []E) float64 {
+	return OverlapCoefficientBy(s1, s2, identity[E])
+}
+
+// OverlapCoefficientBy returns the overlap coefficient of the unique keys
+// returned by the function fn for the elements of the slices s1 and s2, i.e.
+// the size of their intersection divided by the size of the smaller set.
+// It is 1 if both slices are empty and 0 if only one of them is empty.
+func OverlapCoefficientBy[E any, K comparable](s1, s2 []E, fn func(e E) K) float64 {
+	n1, n2, i := overlap(s1, s2, fn)
+	switch {
+	case n1 == 0 && n2 == 0:
+		return 1
+	case n1 == 0 || n2 == 0:
+		return 0
+	case n2 < n1:
+		n1 = n2
+	}
+	return float64(i) / float64(n1)
+}
+
+// CosineSimilarity returns the cosine similarity of the vectors counting the
+// occurrences of each element in the slices s1 and s2. It is 1 if both slices
+// are empty and 0 if only one of them is empty.
+func CosineSimilarity[E comparable](s1, s2 []E) float64 {
+	return CosineSimilarityBy(s1, s2, identity[E])
+}
+
+// CosineSimilarityBy returns the cosine similarity of the vectors counting the
+// occurrences of each key returned by the function fn for the elements of the
+// slices s1 and s2. It is 1 if both slices are empty and 0 if only one of
+// them is empty.
+func CosineSimilarityBy[E any, K comparable](s1, s2 []E, fn func(e E) K) float64 {
+	switch {
+	case len(s1) == 0 && len(s2) == 0:
+		return 1
+	case len(s1) == 0 || len(s2) == 0:
+		return 0
+	}
+	c1 := make(map[K]int, len(s1))
+	for _, e := range s1 {
+		c1[fn(e)]++
+	}
+	c2 := make(map[K]int, len(s2))
+	for _, e := range s2 {
+		c2[fn(e)]++
+	}
+	var dot, norm1, norm2 float64
+	for k, n := range c1 {
+		norm1 += float64(n * n)
+		dot += float64(n * c2[k])
+	}
+	for _, n := range c2 {
+		norm2 += float64(n * n)
+	}
+	return dot / (math.Sqrt(norm1) * math.Sqrt(norm2))
+}
+
+// identity returns the element e itself.
+func identity[E any](e E) E {
+	return e
+}
+
+// overlap returns the number of unique keys returned by the function fn for
+// the elements of the slices s1 and s2, and the number of keys in both.
+func overlap[E any, K comparable](s1, s2 []E, fn func(e E) K) (n1, n2, intersection int) {
+	// seen maps each key of s1 to false, which turns true once the key
+	// has also been found in s2.
+	seen := make(map[K]bool, len(s1))
+	for _, e := range s1 {
+		seen[fn(e)] = false
+	}
+	n1 = len(seen)
+	others := make(map[K]struct{})
+	for _, e := range s2 {
+		k := fn(e)
+		found, ok := seen[k]
+		switch {
+		case ok && !found:
+			seen[k] = true
+			intersection++
+			n2++
+		case !ok:
+			if _, ok := others[k]; !ok {
+				others[k] = struct{}{}
+				n2++
+			}
+		}
+	}
+	return n1, n2, intersection
+}
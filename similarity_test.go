@@ -0,0 +1,99 @@
+package slices
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+// assertFloat checks that the actual value is within 1e-9 of the expected value.
+func assertFloat(t *testing.T, expected, actual float64) {
+	if math.Abs(expected-actual) > 1e-9 {
+		t.Errorf("Test %s: Expected `%v`, Received `%v`", t.Name(), expected, actual)
+	}
+}
+
+func TestJaccard(t *testing.T) {
+	tests := []struct {
+		s1, s2 []int
+		e      float64
+	}{
+		{s1: nil, s2: nil, e: 1},
+		{s1: []int{1}, s2: nil, e: 0},
+		{s1: []int{1, 2, 3}, s2: []int{1, 2, 3}, e: 1},
+		{s1: []int{1, 2, 3}, s2: []int{4, 5}, e: 0},
+		{s1: []int{1, 2, 3, 4}, s2: []int{3, 4, 5, 6}, e: 2.0 / 6},
+		{s1: []int{1, 1, 2}, s2: []int{2, 2, 3}, e: 1.0 / 3},
+	}
+
+	for _, test := range tests {
+		assertFloat(t, test.e, Jaccard(test.s1, test.s2))
+		assertFloat(t, test.e, Jaccard(test.s2, test.s1))
+	}
+}
+
+func TestJaccardBy(t *testing.T) {
+	assertFloat(t, 1, JaccardBy([]string{"A", "b"}, []string{"a", "B", "b"}, strings.ToLower))
+}
+
+func TestSorensenDice(t *testing.T) {
+	tests := []struct {
+		s1, s2 []int
+		e      float64
+	}{
+		{s1: nil, s2: nil, e: 1},
+		{s1: []int{1}, s2: nil, e: 0},
+		{s1: []int{1, 2, 3, 4}, s2: []int{3, 4, 5, 6}, e: 0.5},
+		{s1: []int{1, 1, 2}, s2: []int{2, 2, 3}, e: 0.5},
+	}
+
+	for _, test := range tests {
+		assertFloat(t, test.e, SorensenDice(test.s1, test.s2))
+	}
+}
+
+func TestSorensenDiceBy(t *testing.T) {
+	assertFloat(t, 2.0/3, SorensenDiceBy([]string{"A", "b"}, []string{"a"}, strings.ToLower))
+}
+
+func TestOverlapCoefficient(t *testing.T) {
+	tests := []struct {
+		s1, s2 []int
+		e      float64
+	}{
+		{s1: nil, s2: nil, e: 1},
+		{s1: []int{1}, s2: nil, e: 0},
+		{s1: []int{1, 2}, s2: []int{1, 2, 3, 4}, e: 1},
+		{s1: []int{1, 2, 3, 4}, s2: []int{3, 4, 5, 6}, e: 0.5},
+	}
+
+	for _, test := range tests {
+		assertFloat(t, test.e, OverlapCoefficient(test.s1, test.s2))
+		assertFloat(t, test.e, OverlapCoefficient(test.s2, test.s1))
+	}
+}
+
+func TestOverlapCoefficientBy(t *testing.T) {
+	assertFloat(t, 1, OverlapCoefficientBy([]string{"A"}, []string{"a", "b"}, strings.ToLower))
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	tests := []struct {
+		s1, s2 []string
+		e      float64
+	}{
+		{s1: nil, s2: nil, e: 1},
+		{s1: []string{"a"}, s2: nil, e: 0},
+		{s1: []string{"a", "b"}, s2: []string{"b", "a"}, e: 1},
+		{s1: []string{"a"}, s2: []string{"b"}, e: 0},
+		{s1: []string{"a", "a", "b"}, s2: []string{"a", "b", "b"}, e: 4.0 / 5},
+	}
+
+	for _, test := range tests {
+		assertFloat(t, test.e, CosineSimilarity(test.s1, test.s2))
+	}
+}
+
+func TestCosineSimilarityBy(t *testing.T) {
+	assertFloat(t, 1, CosineSimilarityBy([]string{"A", "b"}, []string{"a", "B"}, strings.ToLower))
+}
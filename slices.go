@@ -8,6 +8,12 @@ type number interface {
 	/* Signed */ ~int | ~int8 | ~int16 | ~int32 | ~int64 | /* Unsigned */ ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr | /* Float */ ~float32 | ~float64 | /* Complex */ ~complex64 | ~complex128
 }
 
+// realNumber is a constraint that permits any real numeric type: any type
+// that supports the operators + - * / and can be converted to float64.
+type realNumber interface {
+	/* Signed */ ~int | ~int8 | ~int16 | ~int32 | ~int64 | /* Unsigned */ ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr | /* Float */ ~float32 | ~float64
+}
+
 // ordered is a constraint that permits any ordered type: any type
 // that supports the operators < <= >= >.
 // If future releases of Go add new ordered types,
@@ -20,6 +26,11 @@ type ordered interface {
 var (
 	errEmptySlice      = errors.New("slices: empty slice")
 	errElementNotFound = errors.New("slices: no such element")
+	errIndexOutOfRange = errors.New("slices: index out of range")
+	errInvalidSize     = errors.New("slices: size must be positive")
+	errInvalidType     = errors.New("slices: invalid element type")
+	errInvalidWeight   = errors.New("slices: invalid weight")
+	errPatchMismatch   = errors.New("slices: patch does not apply")
 )
 
 // Index returns the index of the first occurrence of v in e,
@@ -120,6 +131,59 @@ func Reduce[E any](s []E, fn func(acc, e E) E) E {
 	return acc
 }
 
+// Fold accumulates a value starting with init and applying the function fn
+// from left to right to the current accumulator value and each element
+// of the slice s.
+//
+// If the slice is empty, Fold returns init.
+func Fold[E, A any](s []E, init A, fn func(acc A, e E) A) A {
+	acc := init
+	for _, e := range s {
+		acc = fn(acc, e)
+	}
+	return acc
+}
+
+// FoldRight accumulates a value starting with init and applying the function
+// fn from right to left to each element of the slice s and the current
+// accumulator value.
+//
+// If the slice is empty, FoldRight returns init.
+func FoldRight[E, A any](s []E, init A, fn func(e E, acc A) A) A {
+	acc := init
+	for i := len(s) - 1; i >= 0; i-- {
+		acc = fn(s[i], acc)
+	}
+	return acc
+}
+
+// Scan returns a slice with same length as s containing the successive
+// accumulator values of folding the slice s from left to right with the
+// function fn, starting with init. The value init itself is not included.
+//
+// For example, scanning with an addition function yields the prefix sums.
+func Scan[E, A any](s []E, init A, fn func(acc A, e E) A) []A {
+	r := make([]A, len(s))
+	acc := init
+	for i, e := range s {
+		acc = fn(acc, e)
+		r[i] = acc
+	}
+	return r
+}
+
+// ScanLeft returns a slice containing init followed by the successive
+// accumulator values of folding the slice s from left to right with the
+// function fn. The result is always one element longer than s.
+func ScanLeft[E, A any](s []E, init A, fn func(acc A, e E) A) []A {
+	r := make([]A, len(s)+1)
+	r[0] = init
+	for i, e := range s {
+		r[i+1] = fn(r[i], e)
+	}
+	return r
+}
+
 // All returns true if the evaluation of the predicate function fn
 // returns true for all elements of the slice e.
 func All[E any](s []E, fn func(e E) bool) bool {
@@ -227,22 +291,61 @@ func Flatten[E any](s [][]E) []E {
 }
 
 // Chunked returns a slice of slices, each with the size n containing the
-// elements of the original slice e.
+// elements of the original slice e. The last slice may be smaller.
+//
+// If n is not positive, Chunked will panic.
 func Chunked[E any](s []E, n int) [][]E {
-	c := len(s) / n
-	if len(s)%n != 0 {
-		c++
-	}
-	r := make([][]E, c)
-	for i := 0; i < c; i++ {
-		m := n
-		if i == c-1 {
-			m = len(s) - i*n
-		}
-		r[i] = make([]E, m)
-		for j := range r[i] {
-			r[i][j] = s[i*n+j]
+	r := ChunkedView(s, n)
+	for i, c := range r {
+		r[i] = clone(c)
+	}
+	return r
+}
+
+// ChunkedView returns a slice of slices, each with the size n containing the
+// elements of the original slice e. The last slice may be smaller.
+//
+// The chunks share the underlying array of slice e, so no elements are
+// copied. Their capacity is limited to their length, thus appending to a
+// chunk never overwrites elements of the slice e.
+//
+// If n is not positive, ChunkedView will panic.
+func ChunkedView[E any](s []E, n int) [][]E {
+	if n <= 0 {
+		panic(errInvalidSize)
+	}
+	return WindowedView(s, n, n, true)
+}
+
+// SplitN splits the slice into n slices of consecutive elements, whose sizes
+// differ by at most one. The larger slices come first. If s has less than n
+// elements, the trailing slices are empty.
+//
+// If n is not positive, SplitN will panic.
+func SplitN[E any](s []E, n int) [][]E {
+	if n <= 0 {
+		panic(errInvalidSize)
+	}
+	r := splitNView(s, n)
+	for i, p := range r {
+		r[i] = clone(p)
+	}
+	return r
+}
+
+// splitNView splits the slice into n slices of consecutive elements, whose
+// sizes differ by at most one, sharing the underlying array of slice s.
+func splitNView[E any](s []E, n int) [][]E {
+	q, m := len(s)/n, len(s)%n
+	r := make([][]E, n)
+	j := 0
+	for i := range r {
+		k := j + q
+		if i < m {
+			k++
 		}
+		r[i] = s[j:k:k]
+		j = k
 	}
 	return r
 }
@@ -2,6 +2,7 @@
 
 import (
 	"reflect"
+	"strconv"
 	"testing"
 	"unsafe"
 )
@@ -326,6 +327,86 @@ func TestReduce(t *testing.T) {
 	assertPanic(t, errEmptySlice, func() { Reduce([]int{}, func(acc, i int) int { return acc + i }) })
 }
 
+func TestFold(t *testing.T) {
+	type Person struct {
+		firstname, lastname string
+	}
+
+	tests := []struct {
+		s []*Person
+		e map[string]int
+	}{
+		{s: nil, e: map[string]int{}},
+		{
+			s: []*Person{
+				{
+					firstname: "Grace",
+					lastname:  "Hoper",
+				},
+				{
+					firstname: "Jacob",
+					lastname:  "Bernoulli",
+				},
+				{
+					firstname: "Johann",
+					lastname:  "Bernoulli",
+				},
+			},
+			e: map[string]int{"Hoper": 1, "Bernoulli": 2},
+		},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, Fold(test.s, map[string]int{}, func(acc map[string]int, p *Person) map[string]int {
+			acc[p.lastname]++
+			return acc
+		}))
+	}
+}
+
+func TestFoldRight(t *testing.T) {
+	tests := []struct {
+		s []int
+		e string
+	}{
+		{s: nil, e: ""},
+		{s: []int{1}, e: "1"},
+		{s: []int{1, 2, 3}, e: "321"},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, FoldRight(test.s, "", func(i int, acc string) string { return acc + strconv.Itoa(i) }))
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		s, e []int
+	}{
+		{s: nil, e: []int{}},
+		{s: []int{1}, e: []int{1}},
+		{s: []int{1, 2, 3, 4, 5}, e: []int{1, 3, 6, 10, 15}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, Scan(test.s, 0, func(acc, i int) int { return acc + i }))
+	}
+}
+
+func TestScanLeft(t *testing.T) {
+	tests := []struct {
+		s, e []int
+	}{
+		{s: nil, e: []int{10}},
+		{s: []int{1}, e: []int{10, 11}},
+		{s: []int{1, 2, 3, 4, 5}, e: []int{10, 11, 13, 16, 20, 25}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, ScanLeft(test.s, 10, func(acc, i int) int { return acc + i }))
+	}
+}
+
 func TestAll(t *testing.T) {
 	tests := []struct {
 		s []int
@@ -557,8 +638,48 @@ func TestChunked(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		assertEqual(t, test.e, Chunked(test.s, test.n))
+		chunks := Chunked(test.s, test.n)
+		assertEqual(t, test.e, chunks)
+		if len(chunks) > 0 && unsafe.Pointer(&test.s[0]) == unsafe.Pointer(&chunks[0][0]) {
+			t.Errorf("Test %s: Expected s1 and s2 to not be the same slice", t.Name())
+		}
+	}
+
+	assertPanic(t, errInvalidSize, func() { Chunked([]int{1, 2}, 0) })
+	assertPanic(t, errInvalidSize, func() { Chunked([]int{1, 2}, -1) })
+}
+
+func TestChunkedView(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	chunks := ChunkedView(s, 2)
+	assertEqual(t, [][]int{{1, 2}, {3, 4}, {5}}, chunks)
+	assertEqual(t, unsafe.Pointer(&s[2]), unsafe.Pointer(&chunks[1][0]))
+
+	_ = append(chunks[0], 0)
+	assertEqual(t, []int{1, 2, 3, 4, 5}, s)
+
+	assertEqual(t, [][]int{}, ChunkedView([]int{}, 2))
+	assertPanic(t, errInvalidSize, func() { ChunkedView([]int{1, 2}, 0) })
+}
+
+func TestSplitN(t *testing.T) {
+	tests := []struct {
+		s []int
+		n int
+		e [][]int
+	}{
+		{s: nil, n: 2, e: [][]int{{}, {}}},
+		{s: []int{1, 2, 3, 4, 5}, n: 1, e: [][]int{{1, 2, 3, 4, 5}}},
+		{s: []int{1, 2, 3, 4, 5}, n: 2, e: [][]int{{1, 2, 3}, {4, 5}}},
+		{s: []int{1, 2, 3, 4, 5, 6, 7}, n: 3, e: [][]int{{1, 2, 3}, {4, 5}, {6, 7}}},
+		{s: []int{1, 2}, n: 3, e: [][]int{{1}, {2}, {}}},
 	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, SplitN(test.s, test.n))
+	}
+
+	assertPanic(t, errInvalidSize, func() { SplitN([]int{1, 2}, 0) })
 }
 
 func TestUnique(t *testing.T) {
@@ -0,0 +1,85 @@
+package slices
+
+// clamp returns n limited to the range [0, l].
+func clamp(n, l int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > l {
+		return l
+	}
+	return n
+}
+
+// clone returns a newly allocated slice containing the elements of s.
+func clone[E any](s []E) []E {
+	r := make([]E, len(s))
+	copy(r, s)
+	return r
+}
+
+// Take returns a newly allocated slice containing the first n elements of
+// the slice s. If s has less than n elements, all elements are returned.
+func Take[E any](s []E, n int) []E {
+	return clone(s[:clamp(n, len(s))])
+}
+
+// Drop returns a newly allocated slice containing all except the first n
+// elements of the slice s. If s has less than n elements, the result is empty.
+func Drop[E any](s []E, n int) []E {
+	return clone(s[clamp(n, len(s)):])
+}
+
+// TakeLast returns a newly allocated slice containing the last n elements of
+// the slice s. If s has less than n elements, all elements are returned.
+func TakeLast[E any](s []E, n int) []E {
+	return clone(s[len(s)-clamp(n, len(s)):])
+}
+
+// DropLast returns a newly allocated slice containing all except the last n
+// elements of the slice s. If s has less than n elements, the result is empty.
+func DropLast[E any](s []E, n int) []E {
+	return clone(s[:len(s)-clamp(n, len(s))])
+}
+
+// TakeWhile returns a newly allocated slice containing the longest prefix of
+// the slice s of elements for which the function fn returns true.
+func TakeWhile[E any](s []E, fn func(e E) bool) []E {
+	return clone(s[:prefixLen(s, fn)])
+}
+
+// DropWhile returns a newly allocated slice containing all elements of the
+// slice s except the longest prefix of elements for which the function fn
+// returns true.
+func DropWhile[E any](s []E, fn func(e E) bool) []E {
+	return clone(s[prefixLen(s, fn):])
+}
+
+// TakeLastWhile returns a newly allocated slice containing the longest suffix
+// of the slice s of elements for which the function fn returns true.
+func TakeLastWhile[E any](s []E, fn func(e E) bool) []E {
+	i := len(s)
+	for i > 0 && fn(s[i-1]) {
+		i--
+	}
+	return clone(s[i:])
+}
+
+// SpanBy splits the slice into a pair of newly allocated slices, where the
+// first slice contains the longest prefix of elements for which the function
+// fn returns true, while the second slice contains the remaining elements.
+func SpanBy[E any](s []E, fn func(e E) bool) ([]E, []E) {
+	n := prefixLen(s, fn)
+	return clone(s[:n]), clone(s[n:])
+}
+
+// prefixLen returns the length of the longest prefix of the slice s
+// of elements for which the function fn returns true.
+func prefixLen[E any](s []E, fn func(e E) bool) int {
+	for i, e := range s {
+		if !fn(e) {
+			return i
+		}
+	}
+	return len(s)
+}
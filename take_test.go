@@ -0,0 +1,141 @@
+package slices
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestTake(t *testing.T) {
+	tests := []struct {
+		s []int
+		n int
+		e []int
+	}{
+		{s: nil, n: 2, e: []int{}},
+		{s: []int{1, 2, 3}, n: -1, e: []int{}},
+		{s: []int{1, 2, 3}, n: 0, e: []int{}},
+		{s: []int{1, 2, 3}, n: 2, e: []int{1, 2}},
+		{s: []int{1, 2, 3}, n: 5, e: []int{1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		taken := Take(test.s, test.n)
+		assertEqual(t, test.e, taken)
+		if len(taken) > 0 && unsafe.Pointer(&test.s[0]) == unsafe.Pointer(&taken[0]) {
+			t.Errorf("Test %s: Expected s1 and s2 to not be the same slice", t.Name())
+		}
+	}
+}
+
+func TestDrop(t *testing.T) {
+	tests := []struct {
+		s []int
+		n int
+		e []int
+	}{
+		{s: nil, n: 2, e: []int{}},
+		{s: []int{1, 2, 3}, n: -1, e: []int{1, 2, 3}},
+		{s: []int{1, 2, 3}, n: 2, e: []int{3}},
+		{s: []int{1, 2, 3}, n: 5, e: []int{}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, Drop(test.s, test.n))
+	}
+}
+
+func TestTakeLast(t *testing.T) {
+	tests := []struct {
+		s []int
+		n int
+		e []int
+	}{
+		{s: nil, n: 2, e: []int{}},
+		{s: []int{1, 2, 3}, n: -1, e: []int{}},
+		{s: []int{1, 2, 3}, n: 2, e: []int{2, 3}},
+		{s: []int{1, 2, 3}, n: 5, e: []int{1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, TakeLast(test.s, test.n))
+	}
+}
+
+func TestDropLast(t *testing.T) {
+	tests := []struct {
+		s []int
+		n int
+		e []int
+	}{
+		{s: nil, n: 2, e: []int{}},
+		{s: []int{1, 2, 3}, n: -1, e: []int{1, 2, 3}},
+		{s: []int{1, 2, 3}, n: 2, e: []int{1}},
+		{s: []int{1, 2, 3}, n: 5, e: []int{}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, DropLast(test.s, test.n))
+	}
+}
+
+func TestTakeWhile(t *testing.T) {
+	tests := []struct {
+		s, e []int
+	}{
+		{s: nil, e: []int{}},
+		{s: []int{1, 2, 3, 1}, e: []int{1, 2}},
+		{s: []int{3, 1, 2}, e: []int{}},
+		{s: []int{1, 2}, e: []int{1, 2}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, TakeWhile(test.s, func(i int) bool { return i < 3 }))
+	}
+}
+
+func TestDropWhile(t *testing.T) {
+	tests := []struct {
+		s, e []int
+	}{
+		{s: nil, e: []int{}},
+		{s: []int{1, 2, 3, 1}, e: []int{3, 1}},
+		{s: []int{3, 1, 2}, e: []int{3, 1, 2}},
+		{s: []int{1, 2}, e: []int{}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, DropWhile(test.s, func(i int) bool { return i < 3 }))
+	}
+}
+
+func TestTakeLastWhile(t *testing.T) {
+	tests := []struct {
+		s, e []int
+	}{
+		{s: nil, e: []int{}},
+		{s: []int{1, 3, 2, 1}, e: []int{2, 1}},
+		{s: []int{1, 2, 3}, e: []int{}},
+		{s: []int{1, 2}, e: []int{1, 2}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, TakeLastWhile(test.s, func(i int) bool { return i < 3 }))
+	}
+}
+
+func TestSpanBy(t *testing.T) {
+	tests := []struct {
+		s, e1, e2 []int
+	}{
+		{s: nil, e1: []int{}, e2: []int{}},
+		{s: []int{1, 2, 3, 1}, e1: []int{1, 2}, e2: []int{3, 1}},
+		{s: []int{3, 1}, e1: []int{}, e2: []int{3, 1}},
+		{s: []int{1, 2}, e1: []int{1, 2}, e2: []int{}},
+	}
+
+	for _, test := range tests {
+		e1, e2 := SpanBy(test.s, func(i int) bool { return i < 3 })
+		assertEqual(t, test.e1, e1)
+		assertEqual(t, test.e2, e2)
+	}
+}
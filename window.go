@@ -0,0 +1,145 @@
+package slices
+
+// Windowed returns a slice of overlapping windows of the slice s, each with
+// the given size and started step elements after the previous one. Every
+// window is a newly allocated slice.
+//
+// If partial is true, the trailing windows which are smaller than size are
+// included, otherwise they are dropped.
+// If size or step is not positive, Windowed will panic.
+func Windowed[E any](s []E, size, step int, partial bool) [][]E {
+	r := WindowedView(s, size, step, partial)
+	for i, w := range r {
+		r[i] = clone(w)
+	}
+	return r
+}
+
+// WindowedView returns a slice of overlapping windows of the slice s, each
+// with the given size and started step elements after the previous one.
+//
+// The windows share the underlying array of slice s, so no elements are
+// copied. Their capacity is limited to their length, thus appending to a
+// window never overwrites elements of the slice s.
+//
+// If partial is true, the trailing windows which are smaller than size are
+// included, otherwise they are dropped.
+// If size or step is not positive, WindowedView will panic.
+func WindowedView[E any](s []E, size, step int, partial bool) [][]E {
+	if size <= 0 || step <= 0 {
+		panic(errInvalidSize)
+	}
+	var n int
+	switch {
+	case partial:
+		n = (len(s) + step - 1) / step
+	case len(s) >= size:
+		n = (len(s)-size)/step + 1
+	}
+	r := make([][]E, n)
+	for i := range r {
+		j := i * step
+		k := j + size
+		if k > len(s) {
+			k = len(s)
+		}
+		r[i] = s[j:k:k]
+	}
+	return r
+}
+
+// MovingSumOf returns the sums of all values produced by applying the
+// function fn to the elements of each window of the given size in the
+// slice s, sliding by one element at a time.
+//
+// Each element is visited only once, thus it runs in O(n) time regardless
+// of the window size.
+// If size is not positive, MovingSumOf will panic.
+func MovingSumOf[E any, N number](s []E, size int, fn func(e E) N) []N {
+	if size <= 0 {
+		panic(errInvalidSize)
+	}
+	if len(s) < size {
+		return []N{}
+	}
+	v := Map(s, fn)
+	r := make([]N, len(s)-size+1)
+	var sum N
+	for i, n := range v {
+		sum += n
+		if i >= size {
+			sum -= v[i-size]
+		}
+		if i >= size-1 {
+			r[i-size+1] = sum
+		}
+	}
+	return r
+}
+
+// MovingMeanOf returns the arithmetic means of all values produced by
+// applying the function fn to the elements of each window of the given size
+// in the slice s, sliding by one element at a time.
+//
+// Each element is visited only once, thus it runs in O(n) time regardless
+// of the window size.
+// If size is not positive, MovingMeanOf will panic.
+func MovingMeanOf[E any, N realNumber](s []E, size int, fn func(e E) N) []float64 {
+	return Map(MovingSumOf(s, size, func(e E) float64 { return float64(fn(e)) }), func(sum float64) float64 {
+		return sum / float64(size)
+	})
+}
+
+// MovingMinOf returns the smallest value among the values produced by
+// applying the function fn to the elements of each window of the given size
+// in the slice s, sliding by one element at a time.
+//
+// It uses a monotonic deque, thus it runs in O(n) time regardless of the
+// window size.
+// If size is not positive, MovingMinOf will panic.
+func MovingMinOf[E any, N ordered](s []E, size int, fn func(e E) N) []N {
+	return movingExtremaOf(s, size, fn, func(a, b N) bool { return a < b })
+}
+
+// MovingMaxOf returns the largest value among the values produced by
+// applying the function fn to the elements of each window of the given size
+// in the slice s, sliding by one element at a time.
+//
+// It uses a monotonic deque, thus it runs in O(n) time regardless of the
+// window size.
+// If size is not positive, MovingMaxOf will panic.
+func MovingMaxOf[E any, N ordered](s []E, size int, fn func(e E) N) []N {
+	return movingExtremaOf(s, size, fn, func(a, b N) bool { return a > b })
+}
+
+// movingExtremaOf returns the extremum of each window of the given size as
+// determined by the function better, which reports whether a is strictly
+// preferred over b.
+func movingExtremaOf[E any, N ordered](s []E, size int, fn func(e E) N, better func(a, b N) bool) []N {
+	if size <= 0 {
+		panic(errInvalidSize)
+	}
+	if len(s) < size {
+		return []N{}
+	}
+	v := Map(s, fn)
+	r := make([]N, len(s)-size+1)
+	// q holds the indices of the current window in q[h:t], ordered such
+	// that their values are strictly monotonic with the extremum at q[h].
+	q := make([]int, len(v))
+	h, t := 0, 0
+	for i, n := range v {
+		for t > h && !better(v[q[t-1]], n) {
+			t--
+		}
+		q[t] = i
+		t++
+		if q[h] <= i-size {
+			h++
+		}
+		if i >= size-1 {
+			r[i-size+1] = v[q[h]]
+		}
+	}
+	return r
+}
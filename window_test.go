@@ -0,0 +1,131 @@
+package slices
+
+import (
+	"math/rand"
+	"testing"
+	"unsafe"
+)
+
+func TestWindowed(t *testing.T) {
+	tests := []struct {
+		s          []int
+		size, step int
+		partial    bool
+		e          [][]int
+	}{
+		{s: nil, size: 2, step: 1, partial: false, e: [][]int{}},
+		{s: []int{1}, size: 2, step: 1, partial: false, e: [][]int{}},
+		{s: []int{1}, size: 2, step: 1, partial: true, e: [][]int{{1}}},
+		{s: []int{1, 2, 3, 4}, size: 2, step: 1, partial: false, e: [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{s: []int{1, 2, 3, 4}, size: 2, step: 1, partial: true, e: [][]int{{1, 2}, {2, 3}, {3, 4}, {4}}},
+		{s: []int{1, 2, 3, 4, 5}, size: 3, step: 2, partial: false, e: [][]int{{1, 2, 3}, {3, 4, 5}}},
+		{s: []int{1, 2, 3, 4, 5, 6}, size: 3, step: 2, partial: false, e: [][]int{{1, 2, 3}, {3, 4, 5}}},
+		{s: []int{1, 2, 3, 4, 5, 6}, size: 3, step: 2, partial: true, e: [][]int{{1, 2, 3}, {3, 4, 5}, {5, 6}}},
+		{s: []int{1, 2, 3, 4, 5}, size: 2, step: 3, partial: true, e: [][]int{{1, 2}, {4, 5}}},
+	}
+
+	for _, test := range tests {
+		windows := Windowed(test.s, test.size, test.step, test.partial)
+		assertEqual(t, test.e, windows)
+		if len(windows) > 0 && unsafe.Pointer(&test.s[0]) == unsafe.Pointer(&windows[0][0]) {
+			t.Errorf("Test %s: Expected s1 and s2 to not be the same slice", t.Name())
+		}
+	}
+
+	assertPanic(t, errInvalidSize, func() { Windowed([]int{1}, 0, 1, false) })
+	assertPanic(t, errInvalidSize, func() { Windowed([]int{1}, 1, 0, false) })
+}
+
+func TestWindowedView(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	windows := WindowedView(s, 3, 1, true)
+	assertEqual(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}, {4, 5}, {5}}, windows)
+	assertEqual(t, unsafe.Pointer(&s[1]), unsafe.Pointer(&windows[1][0]))
+	assertEqual(t, 3, cap(windows[0]))
+
+	_ = append(windows[0], 0)
+	assertEqual(t, []int{1, 2, 3, 4, 5}, s)
+
+	assertPanic(t, errInvalidSize, func() { WindowedView([]int{1}, -1, 1, false) })
+}
+
+func TestMovingSumOf(t *testing.T) {
+	tests := []struct {
+		s    []int
+		size int
+		e    []int
+	}{
+		{s: nil, size: 1, e: []int{}},
+		{s: []int{1, 2}, size: 3, e: []int{}},
+		{s: []int{1, 2, 3}, size: 3, e: []int{6}},
+		{s: []int{1, 2, 3, 4, 5}, size: 1, e: []int{1, 2, 3, 4, 5}},
+		{s: []int{1, 2, 3, 4, 5}, size: 2, e: []int{3, 5, 7, 9}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, MovingSumOf(test.s, test.size, func(i int) int { return i }))
+	}
+
+	assertPanic(t, errInvalidSize, func() { MovingSumOf([]int{1}, 0, func(i int) int { return i }) })
+}
+
+func TestMovingMeanOf(t *testing.T) {
+	type Sample struct {
+		value int
+	}
+
+	s := []Sample{{1}, {2}, {3}, {4}, {6}}
+	assertEqual(t, []float64{1.5, 2.5, 3.5, 5}, MovingMeanOf(s, 2, func(e Sample) int { return e.value }))
+	assertEqual(t, []float64{}, MovingMeanOf(s, 6, func(e Sample) int { return e.value }))
+}
+
+func TestMovingMinOf(t *testing.T) {
+	tests := []struct {
+		s    []int
+		size int
+		e    []int
+	}{
+		{s: nil, size: 1, e: []int{}},
+		{s: []int{4, 2, 12, 3, 8, 1, 7}, size: 3, e: []int{2, 2, 3, 1, 1}},
+		{s: []int{1, 1, 1, 1}, size: 2, e: []int{1, 1, 1}},
+		{s: []int{1, 2, 3, 4}, size: 2, e: []int{1, 2, 3}},
+		{s: []int{4, 3, 2, 1}, size: 2, e: []int{3, 2, 1}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, MovingMinOf(test.s, test.size, func(i int) int { return i }))
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	s := Map(make([]int, 200), func(int) int { return rnd.Intn(20) })
+	for _, size := range []int{1, 5, 17, 200} {
+		e := Map(WindowedView(s, size, 1, false), func(w []int) int { return MinOf(w, func(i int) int { return i }) })
+		assertEqual(t, e, MovingMinOf(s, size, func(i int) int { return i }))
+	}
+
+	assertPanic(t, errInvalidSize, func() { MovingMinOf([]int{1}, 0, func(i int) int { return i }) })
+}
+
+func TestMovingMaxOf(t *testing.T) {
+	tests := []struct {
+		s    []int
+		size int
+		e    []int
+	}{
+		{s: nil, size: 1, e: []int{}},
+		{s: []int{4, 2, 12, 3, 8, 1, 7}, size: 3, e: []int{12, 12, 12, 8, 8}},
+		{s: []int{1, 2, 3, 4}, size: 2, e: []int{2, 3, 4}},
+		{s: []int{4, 3, 2, 1}, size: 2, e: []int{4, 3, 2}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, MovingMaxOf(test.s, test.size, func(i int) int { return i }))
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	s := Map(make([]int, 200), func(int) int { return rnd.Intn(20) })
+	for _, size := range []int{1, 5, 17, 200} {
+		e := Map(WindowedView(s, size, 1, false), func(w []int) int { return MaxOf(w, func(i int) int { return i }) })
+		assertEqual(t, e, MovingMaxOf(s, size, func(i int) int { return i }))
+	}
+}
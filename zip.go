@@ -0,0 +1,105 @@
+package slices
+
+// Pair represents a generic pair of two values.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple represents a generic triple of three values.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Zip returns a slice of pairs built from the elements of the slices s1 and
+// s2 with the same index.
+//
+// If the slices differ in length, the result has the length of the shortest
+// slice and the remaining elements of the longer slice are ignored.
+func Zip[A, B any](s1 []A, s2 []B) []Pair[A, B] {
+	return ZipWith(s1, s2, func(a A, b B) Pair[A, B] { return Pair[A, B]{a, b} })
+}
+
+// Zip3 returns a slice of triples built from the elements of the slices
+// s1, s2 and s3 with the same index.
+//
+// If the slices differ in length, the result has the length of the shortest
+// slice and the remaining elements of the longer slices are ignored.
+func Zip3[A, B, C any](s1 []A, s2 []B, s3 []C) []Triple[A, B, C] {
+	n := len(s1)
+	if len(s2) < n {
+		n = len(s2)
+	}
+	if len(s3) < n {
+		n = len(s3)
+	}
+	r := make([]Triple[A, B, C], n)
+	for i := range r {
+		r[i] = Triple[A, B, C]{s1[i], s2[i], s3[i]}
+	}
+	return r
+}
+
+// ZipWith returns a slice of the results of applying the function fn to the
+// elements of the slices s1 and s2 with the same index.
+//
+// If the slices differ in length, the result has the length of the shortest
+// slice and the remaining elements of the longer slice are ignored.
+func ZipWith[A, B, R any](s1 []A, s2 []B, fn func(a A, b B) R) []R {
+	n := len(s1)
+	if len(s2) < n {
+		n = len(s2)
+	}
+	r := make([]R, n)
+	for i := range r {
+		r[i] = fn(s1[i], s2[i])
+	}
+	return r
+}
+
+// ZipLongest returns a slice of pairs built from the elements of the slices
+// s1 and s2 with the same index.
+//
+// If the slices differ in length, the result has the length of the longest
+// slice and the missing elements of the shorter slice are replaced by the
+// fill value fill1 or fill2 respectively.
+func ZipLongest[A, B any](s1 []A, s2 []B, fill1 A, fill2 B) []Pair[A, B] {
+	n := len(s1)
+	if len(s2) > n {
+		n = len(s2)
+	}
+	r := make([]Pair[A, B], n)
+	for i := range r {
+		r[i] = Pair[A, B]{fill1, fill2}
+		if i < len(s1) {
+			r[i].First = s1[i]
+		}
+		if i < len(s2) {
+			r[i].Second = s2[i]
+		}
+	}
+	return r
+}
+
+// Unzip returns a pair of slices, where the first slice contains the first
+// values and the second slice contains the second values of all pairs in
+// the slice s.
+func Unzip[A, B any](s []Pair[A, B]) ([]A, []B) {
+	r1, r2 := make([]A, len(s)), make([]B, len(s))
+	for i, p := range s {
+		r1[i], r2[i] = p.First, p.Second
+	}
+	return r1, r2
+}
+
+// Unzip3 returns three slices, containing the first, second and third values
+// of all triples in the slice s respectively.
+func Unzip3[A, B, C any](s []Triple[A, B, C]) ([]A, []B, []C) {
+	r1, r2, r3 := make([]A, len(s)), make([]B, len(s)), make([]C, len(s))
+	for i, t := range s {
+		r1[i], r2[i], r3[i] = t.First, t.Second, t.Third
+	}
+	return r1, r2, r3
+}
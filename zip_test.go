@@ -0,0 +1,86 @@
+package slices
+
+import "testing"
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		s1 []int
+		s2 []string
+		e  []Pair[int, string]
+	}{
+		{s1: nil, s2: nil, e: []Pair[int, string]{}},
+		{s1: []int{1, 2}, s2: nil, e: []Pair[int, string]{}},
+		{s1: []int{1, 2}, s2: []string{"a", "b"}, e: []Pair[int, string]{{1, "a"}, {2, "b"}}},
+		{s1: []int{1, 2, 3}, s2: []string{"a", "b"}, e: []Pair[int, string]{{1, "a"}, {2, "b"}}},
+		{s1: []int{1}, s2: []string{"a", "b"}, e: []Pair[int, string]{{1, "a"}}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, Zip(test.s1, test.s2))
+	}
+}
+
+func TestZip3(t *testing.T) {
+	tests := []struct {
+		s1 []int
+		s2 []string
+		s3 []bool
+		e  []Triple[int, string, bool]
+	}{
+		{s1: nil, s2: nil, s3: nil, e: []Triple[int, string, bool]{}},
+		{s1: []int{1, 2}, s2: []string{"a", "b"}, s3: []bool{true, false}, e: []Triple[int, string, bool]{{1, "a", true}, {2, "b", false}}},
+		{s1: []int{1, 2}, s2: []string{"a", "b"}, s3: []bool{true}, e: []Triple[int, string, bool]{{1, "a", true}}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, Zip3(test.s1, test.s2, test.s3))
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	tests := []struct {
+		s1, s2, e []int
+	}{
+		{s1: nil, s2: nil, e: []int{}},
+		{s1: []int{1, 2, 3}, s2: []int{4, 5, 6}, e: []int{4, 10, 18}},
+		{s1: []int{1, 2, 3}, s2: []int{4}, e: []int{4}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, ZipWith(test.s1, test.s2, func(a, b int) int { return a * b }))
+	}
+}
+
+func TestZipLongest(t *testing.T) {
+	tests := []struct {
+		s1 []int
+		s2 []string
+		e  []Pair[int, string]
+	}{
+		{s1: nil, s2: nil, e: []Pair[int, string]{}},
+		{s1: []int{1, 2}, s2: []string{"a", "b"}, e: []Pair[int, string]{{1, "a"}, {2, "b"}}},
+		{s1: []int{1, 2, 3}, s2: []string{"a"}, e: []Pair[int, string]{{1, "a"}, {2, "-"}, {3, "-"}}},
+		{s1: []int{1}, s2: []string{"a", "b"}, e: []Pair[int, string]{{1, "a"}, {-1, "b"}}},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.e, ZipLongest(test.s1, test.s2, -1, "-"))
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	s1, s2 := Unzip([]Pair[int, string]{{1, "a"}, {2, "b"}})
+	assertEqual(t, []int{1, 2}, s1)
+	assertEqual(t, []string{"a", "b"}, s2)
+
+	s1, s2 = Unzip[int, string](nil)
+	assertEqual(t, []int{}, s1)
+	assertEqual(t, []string{}, s2)
+}
+
+func TestUnzip3(t *testing.T) {
+	s1, s2, s3 := Unzip3([]Triple[int, string, bool]{{1, "a", true}, {2, "b", false}})
+	assertEqual(t, []int{1, 2}, s1)
+	assertEqual(t, []string{"a", "b"}, s2)
+	assertEqual(t, []bool{true, false}, s3)
+}